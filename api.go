@@ -17,11 +17,14 @@ type RegisterApi interface {
 type Api struct {
 	p *plugin.Plugin
 
-	Out      Out
-	Global   Global
-	Handler  Handler
-	registry *registry
-	Renderer Renderer
+	Out         Out
+	Global      Global
+	Handler     Handler
+	registry    *registry
+	Renderer    Renderer
+	Diagnostics Diagnostics
+
+	KeyMapRegistry KeyMapRegistry
 }
 
 func newApiWithPlugin(p *plugin.Plugin) *Api {
@@ -31,6 +34,8 @@ func newApiWithPlugin(p *plugin.Plugin) *Api {
 	api.Handler = newHandler(api)
 	api.registry = newRegistry(api)
 	api.Renderer = Renderer{}
+	api.Diagnostics = newDiagnostics(api)
+	api.KeyMapRegistry = newKeyMapRegistry(api)
 
 	return api
 }
@@ -59,6 +64,9 @@ func (api *Api) Executef(format string, args ...interface{}) (string, error) {
 	return api.Execute(fmt.Sprintf(format, args...))
 }
 
+// Function registers `fn` as the function `name`. Neovim waits for `fn` to
+// return, so it must not wait for user interaction, e.g. with Select() or
+// Input(); run these in a goroutine instead.
 func (api *Api) Function(name string, fn interface{}) {
 	api.p.HandleFunction(&plugin.FunctionOptions{Name: name}, fn)
 }
@@ -17,6 +17,7 @@ type Buffer struct {
 	Vars        Vars
 	mutex       *sync.Mutex
 	disposables *disposables.Collection
+	undo        undoGroup
 }
 
 func newBufferById(api *Api, id nvim.Buffer) *Buffer {
@@ -68,6 +69,60 @@ func (b *Buffer) Close() {
 	b.disposables.Dispose()
 }
 
+// Delete unloads the buffer and removes it from the buffer list with
+// :bdelete. With `force` unsaved changes are discarded. Use Close() to wipe the
+// buffer out.
+func (b *Buffer) Delete(force bool) error {
+	cmd := "bdelete"
+	if force {
+		cmd += "!"
+	}
+	if _, err := b.api.Executef("%s %d", cmd, b.id); err != nil {
+		return err
+	}
+	b.api.nvim().DetachBuffer(b.id)
+	b.disposables.Dispose()
+	return nil
+}
+
+func (b *Buffer) IsLoaded() bool {
+	loaded, _ := b.api.nvim().IsBufferLoaded(b.id)
+	return loaded
+}
+
+// Load loads the buffer content without showing the buffer in a window.
+func (b *Buffer) Load() {
+	b.api.nvim().Call("bufload", nil, b.ID())
+}
+
+// Write writes the buffer to its file.
+func (b *Buffer) Write() error {
+	return b.execute("write")
+}
+
+// Reload discards the buffer content and reads the file again.
+func (b *Buffer) Reload() error {
+	return b.execute("edit!")
+}
+
+func (b *Buffer) Modified() bool {
+	return b.Options.Modified()
+}
+
+func (b *Buffer) SetModified(value bool) {
+	b.Options.SetModified(value)
+}
+
+// execute runs the ex command `cmd` with the buffer as current buffer.
+func (b *Buffer) execute(cmd string) error {
+	return b.api.nvim().ExecLua(`
+		local buf, cmd = ...
+		vim.api.nvim_buf_call(buf, function()
+			vim.cmd(cmd)
+		end)
+	`, nil, b.id, cmd)
+}
+
 func (b *Buffer) Path() string {
 	var path string
 	b.api.nvim().Call("expand", &path, fmt.Sprintf(`#%d:p`, b.id))
@@ -149,16 +204,22 @@ func (b *Buffer) Lines() []string {
 
 func (b *Buffer) SetLines(lines []string) {
 	defer b.lock()()
-	defer b.makeWritable()()
 
 	bb := [][]byte{}
 	for _, l := range lines {
 		bb = append(bb, []byte(l))
 	}
 
-	batch := b.api.nvim().NewBatch()
-	batch.SetBufferLines(b.id, 0, -1, false, bb)
-	batch.Execute()
+	b.writable(func() {
+		if b.undo.join() {
+			b.api.nvim().ExecLua(undojoinSetLinesLua, nil, b.id, bb)
+			return
+		}
+
+		batch := b.api.nvim().NewBatch()
+		batch.SetBufferLines(b.id, 0, -1, false, bb)
+		batch.Execute()
+	})
 
 	// TODO debug why diff does not work correctly
 	// currentLines := b.Lines()
@@ -195,26 +256,13 @@ func (b *Buffer) IsEmpty() bool {
 	return true
 }
 
-func (b *Buffer) makeWritable() func() {
-	readonly := b.Options.ReadOnly()
-	modifiable := b.Options.Modifiable()
-
-	if readonly {
-		b.Options.SetReadOnly(false)
-	}
-
-	if !modifiable {
-		b.Options.SetModifiable(true)
-	}
-
-	return func() {
-		if readonly {
-			b.Options.SetReadOnly(readonly)
-		}
-		if !modifiable {
-			b.Options.SetModifiable(modifiable)
-		}
-	}
+// writable runs `fn` with 'readonly' off and 'modifiable' on.
+func (b *Buffer) writable(fn func()) {
+	values := b.Options.Values()
+	b.api.WithOptions([]Override{
+		OptionOverride(values, string(BufferOptionReadOnly), false),
+		OptionOverride(values, string(BufferOptionModifiable), true),
+	}, fn)
 }
 
 func (b *Buffer) lock() func() {
@@ -263,6 +311,26 @@ func (api *Api) FindBuffer(fn func(buffer *Buffer) bool) (*Buffer, bool) {
 	return newBufferById(api, 0), false
 }
 
+// CreateBuffer creates a new buffer without opening a window. A `scratch`
+// buffer is an unlisted "nofile" buffer with 'bufhidden' set to "hide".
+func (api *Api) CreateBuffer(listed, scratch bool) *Buffer {
+	id, _ := api.nvim().CreateBuffer(listed, scratch)
+	return newBufferById(api, id)
+}
+
+// OpenFile returns the (loaded) buffer of the file `path`, without opening a
+// window. The buffer is created if it does not exist yet.
+func (api *Api) OpenFile(path string) *Buffer {
+	var id int
+	api.nvim().Call("bufadd", &id, path)
+
+	b := newBufferById(api, nvim.Buffer(id))
+	b.Options.SetListed(true)
+	b.Load()
+
+	return b
+}
+
 type SplitModifier int
 
 const (
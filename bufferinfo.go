@@ -0,0 +1,88 @@
+package neovim
+
+// BufferInfo is a snapshot of the state of a buffer.
+type BufferInfo struct {
+	ChangedTick  int    `msgpack:"changedtick"`
+	LineCount    int    `msgpack:"line_count"`
+	Loaded       bool   `msgpack:"loaded"`
+	Modified     bool   `msgpack:"modified"`
+	FileEncoding string `msgpack:"fileencoding"`
+	FileFormat   string `msgpack:"fileformat"`
+	FileType     string `msgpack:"filetype"`
+
+	// Size in bytes, counting one byte per end-of-line.
+	Size int `msgpack:"size"`
+}
+
+// Info returns a BufferInfo snapshot, retrieved in one request.
+func (b *Buffer) Info() BufferInfo {
+	var info BufferInfo
+	b.api.nvim().ExecLua(`
+		local buf = ...
+		local loaded = vim.api.nvim_buf_is_loaded(buf)
+		local count = vim.api.nvim_buf_line_count(buf)
+		local opt = function(name) return vim.api.nvim_get_option_value(name, { buf = buf }) end
+
+		return {
+			changedtick = vim.api.nvim_buf_get_changedtick(buf),
+			line_count = count,
+			loaded = loaded,
+			modified = opt('modified'),
+			fileencoding = opt('fileencoding'),
+			fileformat = opt('fileformat'),
+			filetype = opt('filetype'),
+			size = loaded and vim.api.nvim_buf_get_offset(buf, count) or 0,
+		}
+	`, &info, b.id)
+	return info
+}
+
+func (b *Buffer) ChangedTick() int {
+	tick, _ := b.api.nvim().BufferChangedTick(b.id)
+	return tick
+}
+
+func (b *Buffer) LineCount() int {
+	count, _ := b.api.nvim().BufferLineCount(b.id)
+	return count
+}
+
+// ByteOffset returns the byte offset of the start of `line` (1-based). Every
+// end-of-line counts as one byte, independent of 'fileformat', so offsets match
+// the ones of go/token for files with unix line endings.
+func (b *Buffer) ByteOffset(line int) int {
+	offset, _ := b.api.nvim().BufferOffset(b.id, line-1)
+	return offset
+}
+
+// PositionAtOffset returns the position of the byte `offset`. It is the inverse
+// of ByteOffset(): the line is 1-based and the column a 0-based byte index.
+func (b *Buffer) PositionAtOffset(offset int) (Cursor, bool) {
+	var pos []int
+	b.api.nvim().ExecLua(`
+		local buf, offset = ...
+		local count = vim.api.nvim_buf_line_count(buf)
+		if offset < 0 or offset > vim.api.nvim_buf_get_offset(buf, count) then
+			return {}
+		end
+
+		-- binary search for the last line starting at or before offset
+		local lo, hi = 0, count - 1
+		while lo < hi do
+			local mid = math.floor((lo + hi + 1) / 2)
+			if vim.api.nvim_buf_get_offset(buf, mid) <= offset then
+				lo = mid
+			else
+				hi = mid - 1
+			end
+		end
+
+		return { lo + 1, offset - vim.api.nvim_buf_get_offset(buf, lo) }
+	`, &pos, b.id, offset)
+
+	if len(pos) != 2 {
+		return Cursor{}, false
+	}
+
+	return Cursor{pos[0], pos[1]}, true
+}
@@ -14,6 +14,9 @@ const (
 	BufferOptionList      BoolOption   = "list"      // bool? "no"
 	BufferOptionSpell     BoolOption   = "spell"     // bool
 	BufferOptionListchars StringOption = "listchars" // ""
+
+	BufferOptionUndoLevels IntOption  = "undolevels" // int
+	BufferOptionModified   BoolOption = "modified"   // bool
 )
 
 type BufferOptions struct {
@@ -136,24 +139,51 @@ func (o *BufferOptions) FileType() string {
 	return o.getString(BufferOptionFileType)
 }
 
+// modified
+
+func (o *BufferOptions) SetModified(value bool) {
+	o.setBool(BufferOptionModified, value)
+}
+
+func (o *BufferOptions) Modified() bool {
+	return o.getBool(BufferOptionModified)
+}
+
+// undolevels
+
+// Value of the local 'undolevels' option, if the global value is used.
+const BufferUndoLevelsGlobal = -123456
+
+func (o *BufferOptions) SetUndoLevels(value int) {
+	o.setInt(BufferOptionUndoLevels, value)
+}
+
+func (o *BufferOptions) UndoLevels() int {
+	return o.getInt(BufferOptionUndoLevels)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func (o *BufferOptions) getString(name StringOption) string {
-	var value string
-	o.api.nvim().BufferOption(o.bufferID, string(name), &value)
-	return value
+	return o.Values().String(name)
 }
 
 func (o *BufferOptions) setString(name StringOption, value string) {
-	o.api.nvim().SetBufferOption(o.bufferID, string(name), value)
+	o.Values().SetString(name, value)
 }
 
 func (o *BufferOptions) getBool(name BoolOption) bool {
-	var value bool
-	o.api.nvim().BufferOption(o.bufferID, string(name), &value)
-	return value
+	return o.Values().Bool(name)
 }
 
 func (o *BufferOptions) setBool(name BoolOption, value bool) {
-	o.api.nvim().SetBufferOption(o.bufferID, string(name), value)
+	o.Values().SetBool(name, value)
+}
+
+func (o *BufferOptions) getInt(name IntOption) int {
+	return o.Values().Int(name)
+}
+
+func (o *BufferOptions) setInt(name IntOption, value int) {
+	o.Values().SetInt(name, value)
 }
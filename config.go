@@ -0,0 +1,415 @@
+package neovim
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/josa42/go-neovim/disposables"
+	"github.com/neovim/go-client/msgpack"
+)
+
+// ConfigValidator can be implemented by config structs to validate the values
+// after they have been loaded.
+type ConfigValidator interface {
+	Validate() error
+}
+
+const configSetupLua = `
+	local prefix, fn, hid = ...
+	_G.GoNeovimConfig = _G.GoNeovimConfig or {}
+
+	local C = { options = {}, state = { errors = {}, values = {} } }
+	_G.GoNeovimConfig[prefix] = C
+
+	function C.setup(opts)
+		C.options = opts or {}
+		vim.fn[fn](hid)
+	end
+
+	function C.check()
+		local health = vim.health
+		local start = health.start or health.report_start
+		local ok = health.ok or health.report_ok
+		local err = health.error or health.report_error
+		local info = health.info or health.report_info
+
+		start(prefix .. ' configuration')
+		if #C.state.errors == 0 then
+			ok('configuration is valid')
+		end
+		for _, e in ipairs(C.state.errors) do
+			err(e)
+		end
+		for _, v in ipairs(C.state.values) do
+			info(('%s = %s (%s)'):format(v.key, vim.inspect(v.value), v.source))
+		end
+	end
+
+	-- require(prefix).setup() calls C.setup, unless the lua module of the plugin
+	-- defines setup() itself. The module is only replaced if there is none.
+	local function merge(mod)
+		if type(mod) == 'table' and mod.setup == nil then
+			mod.setup = function(...)
+				return _G.GoNeovimConfig[prefix].setup(...)
+			end
+		end
+		return mod
+	end
+
+	if package.loaded[prefix] ~= nil then
+		merge(package.loaded[prefix])
+	end
+
+	if not _G.GoNeovimConfigSearcher then
+		local loaders = package.loaders or package.searchers
+		local function searcher(name)
+			local C = _G.GoNeovimConfig[name]
+			if not C or package.loaded[name] ~= nil then
+				return nil
+			end
+
+			for _, loader in ipairs(loaders) do
+				if loader ~= searcher then
+					local load = loader(name)
+					if type(load) == 'function' then
+						return function(...)
+							return C.merge(load(...))
+						end
+					end
+				end
+			end
+			return function()
+				return C.merge({})
+			end
+		end
+
+		_G.GoNeovimConfigSearcher = searcher
+		table.insert(loaders, 1, searcher)
+	end
+	C.merge = merge
+
+	-- :checkhealth only finds health checks in the runtimepath
+	local dir = vim.fn.stdpath('cache') .. '/go-neovim'
+	local path = ('%s/lua/%s/health.lua'):format(dir, prefix)
+	vim.fn.mkdir(vim.fn.fnamemodify(path, ':h'), 'p')
+	vim.fn.writefile({
+		('return { check = function() _G.GoNeovimConfig[%q].check() end }'):format(prefix),
+	}, path)
+	if not vim.tbl_contains(vim.api.nvim_list_runtime_paths(), dir) then
+		vim.opt.runtimepath:append(dir)
+	end
+`
+
+const configLoadLua = `
+	local prefix, keys = ...
+	local options = _G.GoNeovimConfig[prefix].options
+	local values, unknown = vim.empty_dict(), {}
+
+	for _, key in ipairs(keys) do
+		if options[key] ~= nil then
+			values[key] = { source = 'setup()', value = options[key] }
+		elseif vim.g[prefix .. '_' .. key] ~= nil then
+			values[key] = { source = 'g:' .. prefix .. '_' .. key, value = vim.g[prefix .. '_' .. key] }
+		end
+	end
+
+	for key in pairs(options) do
+		if not vim.tbl_contains(keys, key) then
+			table.insert(unknown, key)
+		end
+	end
+	table.sort(unknown)
+
+	return { values = values, unknown = unknown }
+`
+
+type configValue struct {
+	Source string      `msgpack:"source"`
+	Value  interface{} `msgpack:"value"`
+}
+
+type configField struct {
+	index int
+	key   string
+}
+
+var _ disposables.Disposable = (*Config)(nil)
+
+// Config binds a struct to the variables g:<prefix>_<key> and to the table
+// passed to require('<prefix>').setup({...}). Values from setup() take
+// precedence over variables; missing values keep the defaults of the struct.
+//
+// The key of a field is its name in snake case, or set with a `config` tag:
+//
+//	type TreeConfig struct {
+//	  Width int               `config:"width"`
+//	  Icons map[string]string `config:"icons"`
+//	  Debug bool              `config:"-"`
+//	}
+//
+// setup() is added to the lua module <prefix> of the plugin, if it does not
+// define one itself, or provided as the module if there is none.
+//
+// The config is reloaded when setup() is called again or one of the variables
+// changes, and it is shown in :checkhealth <prefix>.
+type Config struct {
+	api      *Api
+	prefix   string
+	target   reflect.Value
+	defaults reflect.Value
+	fields   []configField
+
+	mutex    *sync.Mutex
+	errors   []string
+	state    []map[string]interface{}
+	onChange []func()
+
+	disposables *disposables.Collection
+}
+
+// BindConfig loads the config into `target`, which has to be a pointer to a
+// struct holding the default values. An error describing all invalid values is
+// returned, invalid values keep their defaults.
+func (api *Api) BindConfig(prefix string, target interface{}) (*Config, error) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		panic("BindConfig: target must be a pointer to a struct")
+	}
+
+	c := &Config{
+		api:         api,
+		prefix:      prefix,
+		target:      v.Elem(),
+		defaults:    reflect.New(v.Elem().Type()).Elem(),
+		fields:      configFields(v.Elem().Type()),
+		mutex:       &sync.Mutex{},
+		disposables: disposables.NewCollection(),
+	}
+	c.defaults.Set(v.Elem())
+
+	handler := api.Handler.Create(func() {
+		c.reload()
+	})
+	c.disposables.Add(handler)
+
+	api.nvim().ExecLua(configSetupLua, nil, prefix, handler.functionName, handler.uuid)
+
+	for _, f := range c.fields {
+		c.disposables.Add(api.Global.Vars.Watch(prefix+"_"+f.key, func(old, new interface{}) {
+			c.reload()
+		}))
+	}
+
+	return c, c.load()
+}
+
+func configFields(t reflect.Type) []configField {
+	fields := []configField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		key := f.Tag.Get("config")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = snakeCase(f.Name)
+		}
+
+		fields = append(fields, configField{index: i, key: key})
+	}
+	return fields
+}
+
+func snakeCase(name string) string {
+	b := strings.Builder{}
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (c *Config) reload() {
+	if err := c.load(); err != nil {
+		c.api.Out.Errorf("%s", err)
+	}
+}
+
+func (c *Config) load() error {
+	keys := []string{}
+	for _, f := range c.fields {
+		keys = append(keys, f.key)
+	}
+
+	var result struct {
+		Values  map[string]configValue `msgpack:"values"`
+		Unknown []string               `msgpack:"unknown"`
+	}
+	if err := c.api.nvim().ExecLua(configLoadLua, &result, c.prefix, keys); err != nil {
+		return err
+	}
+
+	errors := []string{}
+	for _, key := range result.Unknown {
+		errors = append(errors, fmt.Sprintf("setup(): unknown option %q", key))
+	}
+
+	value := reflect.New(c.target.Type()).Elem()
+	value.Set(c.defaults)
+
+	state := []map[string]interface{}{}
+	for _, f := range c.fields {
+		field := value.Field(f.index)
+		source := "default"
+
+		if v, ok := result.Values[f.key]; ok {
+			if err := decodeConfigValue(v.Value, field); err != nil {
+				errors = append(errors, fmt.Sprintf("%s: expected %s, got %s", v.Source, vimTypeName(field.Type()), vimValueTypeName(v.Value)))
+			} else {
+				source = v.Source
+			}
+		}
+
+		state = append(state, map[string]interface{}{
+			"key":    f.key,
+			"value":  field.Interface(),
+			"source": source,
+		})
+	}
+
+	c.mutex.Lock()
+	if validator, ok := value.Addr().Interface().(ConfigValidator); ok {
+		if err := validator.Validate(); err != nil {
+			// keep the previous values
+			errors = append(errors, err.Error())
+			value = c.target
+			state = c.state
+			if state == nil {
+				state = c.defaultState()
+			}
+		}
+	}
+
+	c.target.Set(value)
+	c.errors = errors
+	c.state = state
+	onChange := append([]func(){}, c.onChange...)
+	c.mutex.Unlock()
+
+	c.api.nvim().ExecLua(`
+		local prefix, errors, values = ...
+		_G.GoNeovimConfig[prefix].state = { errors = errors, values = values }
+	`, nil, c.prefix, errors, state)
+
+	for _, fn := range onChange {
+		fn()
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%s: invalid configuration:\n  %s", c.prefix, strings.Join(errors, "\n  "))
+	}
+	return nil
+}
+
+// defaultState describes the default values, which are applied until a valid
+// config has been loaded.
+func (c *Config) defaultState() []map[string]interface{} {
+	state := []map[string]interface{}{}
+	for _, f := range c.fields {
+		state = append(state, map[string]interface{}{
+			"key":    f.key,
+			"value":  c.defaults.Field(f.index).Interface(),
+			"source": "default",
+		})
+	}
+	return state
+}
+
+// decodeConfigValue converts a value decoded into an interface{} to the type of
+// `field`.
+func decodeConfigValue(value interface{}, field reflect.Value) error {
+	buf := &bytes.Buffer{}
+	if err := msgpack.NewEncoder(buf).Encode(value); err != nil {
+		return err
+	}
+
+	v := reflect.New(field.Type())
+	if err := msgpack.NewDecoder(buf).Decode(v.Interface()); err != nil {
+		return err
+	}
+
+	field.Set(v.Elem())
+	return nil
+}
+
+func vimTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.String:
+		return "a string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a number"
+	case reflect.Float32, reflect.Float64:
+		return "a float"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "a dictionary"
+	case reflect.Ptr:
+		return vimTypeName(t.Elem())
+	}
+	return t.String()
+}
+
+func vimValueTypeName(value interface{}) string {
+	if value == nil {
+		return "nil"
+	}
+	return vimTypeName(reflect.TypeOf(value))
+}
+
+// Errors returns the problems found when the config was loaded the last time.
+func (c *Config) Errors() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]string{}, c.errors...)
+}
+
+// Read runs `fn` while the config cannot be reloaded, to read several values
+// consistently.
+func (c *Config) Read(fn func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	fn()
+}
+
+// OnChange calls `fn` after the config has been reloaded.
+func (c *Config) OnChange(fn func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.onChange = append(c.onChange, fn)
+}
+
+// Dispose stops reloading the config.
+func (c *Config) Dispose() {
+	c.disposables.Dispose()
+}
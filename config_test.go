@@ -0,0 +1,23 @@
+package neovim
+
+import "testing"
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Width", "width"},
+		{"ShowIcons", "show_icons"},
+		{"HTTPTimeout", "http_timeout"},
+		{"MaxID", "max_id"},
+		{"Level2", "level2"},
+		{"width", "width"},
+	}
+
+	for _, tt := range tests {
+		if got := snakeCase(tt.name); got != tt.want {
+			t.Errorf("snakeCase(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
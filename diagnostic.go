@@ -0,0 +1,194 @@
+package neovim
+
+import (
+	"fmt"
+
+	"github.com/josa42/go-neovim/disposables"
+)
+
+type DiagnosticSeverity int
+
+const (
+	DiagnosticSeverityError DiagnosticSeverity = iota + 1
+	DiagnosticSeverityWarn
+	DiagnosticSeverityInfo
+	DiagnosticSeverityHint
+)
+
+func (s DiagnosticSeverity) String() string {
+	switch s {
+	case DiagnosticSeverityError:
+		return "ERROR"
+	case DiagnosticSeverityWarn:
+		return "WARN"
+	case DiagnosticSeverityInfo:
+		return "INFO"
+	case DiagnosticSeverityHint:
+		return "HINT"
+	default:
+		return ""
+	}
+}
+
+type Diagnostic struct {
+	Range    Range
+	Severity DiagnosticSeverity
+	Message  string
+	Source   string
+	Code     string
+
+	// Buffer and Namespace are only set for diagnostics read from neovim.
+	Buffer    int
+	Namespace int
+}
+
+// diagnosticEntry is the wire format used by vim.diagnostic, with 0-based lines
+// and columns.
+type diagnosticEntry struct {
+	Buffer    int         `msgpack:"bufnr,omitempty"`
+	Namespace int         `msgpack:"namespace,omitempty"`
+	Line      int         `msgpack:"lnum"`
+	Col       int         `msgpack:"col"`
+	EndLine   int         `msgpack:"end_lnum"`
+	EndCol    int         `msgpack:"end_col"`
+	Severity  int         `msgpack:"severity"`
+	Message   string      `msgpack:"message"`
+	Source    string      `msgpack:"source,omitempty"`
+	Code      interface{} `msgpack:"code,omitempty"`
+}
+
+func newDiagnosticEntry(d Diagnostic) diagnosticEntry {
+	end := d.Range.End
+	if end.Y() == 0 {
+		end = d.Range.Start
+	}
+
+	severity := d.Severity
+	if severity == 0 {
+		severity = DiagnosticSeverityError
+	}
+
+	e := diagnosticEntry{
+		Line:     d.Range.Start.Y() - 1,
+		Col:      d.Range.Start.X(),
+		EndLine:  end.Y() - 1,
+		EndCol:   end.X(),
+		Severity: int(severity),
+		Message:  d.Message,
+		Source:   d.Source,
+	}
+	if d.Code != "" {
+		e.Code = d.Code
+	}
+
+	return e
+}
+
+func (e diagnosticEntry) diagnostic() Diagnostic {
+	code := ""
+	if e.Code != nil {
+		code = fmt.Sprint(e.Code)
+	}
+
+	return Diagnostic{
+		Range:     NewRange(e.Line+1, e.Col, e.EndLine+1, e.EndCol),
+		Severity:  DiagnosticSeverity(e.Severity),
+		Message:   e.Message,
+		Source:    e.Source,
+		Code:      code,
+		Buffer:    e.Buffer,
+		Namespace: e.Namespace,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Diagnostics publishes diagnostics through vim.diagnostic.
+type Diagnostics struct {
+	api *Api
+}
+
+func newDiagnostics(api *Api) Diagnostics {
+	return Diagnostics{api: api}
+}
+
+// Namespace returns the diagnostic namespace `name`, creating it if needed.
+func (d *Diagnostics) Namespace(name string) *DiagnosticNamespace {
+	id, _ := d.api.nvim().CreateNamespace(name)
+	return &DiagnosticNamespace{api: d.api, id: id}
+}
+
+// Get returns the diagnostics of all sources (including the LSP client) for
+// `b`. If `b` is nil, the diagnostics of all buffers are returned.
+func (d *Diagnostics) Get(b *Buffer) []Diagnostic {
+	return getDiagnostics(d.api, b, nil)
+}
+
+func getDiagnostics(api *Api, b *Buffer, namespace interface{}) []Diagnostic {
+	var bufnr interface{}
+	if b != nil {
+		bufnr = b.ID()
+	}
+
+	var entries []diagnosticEntry
+	api.nvim().ExecLua(`
+		local bufnr, ns = ...
+		return vim.diagnostic.get(bufnr, { namespace = ns })
+	`, &entries, bufnr, namespace)
+
+	diagnostics := []Diagnostic{}
+	for _, e := range entries {
+		diagnostics = append(diagnostics, e.diagnostic())
+	}
+	return diagnostics
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+var _ disposables.Disposable = (*DiagnosticNamespace)(nil)
+
+type DiagnosticNamespace struct {
+	api *Api
+	id  int
+}
+
+func (n *DiagnosticNamespace) ID() int {
+	return n.id
+}
+
+// Set replaces the diagnostics of the namespace in `b`.
+func (n *DiagnosticNamespace) Set(b *Buffer, diagnostics []Diagnostic) {
+	entries := []diagnosticEntry{}
+	for _, d := range diagnostics {
+		entries = append(entries, newDiagnosticEntry(d))
+	}
+
+	n.api.nvim().ExecLua(`
+		local ns, bufnr, entries = ...
+		vim.diagnostic.set(ns, bufnr, entries)
+	`, nil, n.id, b.ID(), entries)
+}
+
+// Get returns the diagnostics of the namespace in `b`. If `b` is nil, the
+// diagnostics of all buffers are returned.
+func (n *DiagnosticNamespace) Get(b *Buffer) []Diagnostic {
+	return getDiagnostics(n.api, b, n.id)
+}
+
+// Reset removes the diagnostics of the namespace from `b`. If `b` is nil, they
+// are removed from all buffers.
+func (n *DiagnosticNamespace) Reset(b *Buffer) {
+	var bufnr interface{}
+	if b != nil {
+		bufnr = b.ID()
+	}
+
+	n.api.nvim().ExecLua(`
+		local ns, bufnr = ...
+		vim.diagnostic.reset(ns, bufnr)
+	`, nil, n.id, bufnr)
+}
+
+func (n *DiagnosticNamespace) Dispose() {
+	n.Reset(nil)
+}
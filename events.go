@@ -255,6 +255,15 @@ const (
 	// before leaving a window
 	EventWinLeave = "WinLeave"
 
+	// after closing a window
+	EventWinClosed = "WinClosed"
+
+	// after scrolling the content of a window or resizing a window
+	EventWinScrolled = "WinScrolled"
+
+	// after a window was resized
+	EventWinResized = "WinResized"
+
 	// after entering another tab page
 	EventTabEnter = "TabEnter"
 
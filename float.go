@@ -0,0 +1,228 @@
+package neovim
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/neovim/go-client/nvim"
+)
+
+type FloatRelative string
+
+const (
+	// the global editor grid
+	FloatRelativeEditor FloatRelative = "editor"
+
+	// the window given by FloatConfig.Window, or the current window
+	FloatRelativeWindow FloatRelative = "win"
+
+	// the cursor position in the current window
+	FloatRelativeCursor FloatRelative = "cursor"
+
+	// the mouse position
+	FloatRelativeMouse FloatRelative = "mouse"
+)
+
+type FloatAnchor string
+
+const (
+	FloatAnchorNorthWest FloatAnchor = "NW"
+	FloatAnchorNorthEast FloatAnchor = "NE"
+	FloatAnchorSouthWest FloatAnchor = "SW"
+	FloatAnchorSouthEast FloatAnchor = "SE"
+)
+
+type FloatBorder string
+
+const (
+	FloatBorderNone    FloatBorder = "none"
+	FloatBorderSingle  FloatBorder = "single"
+	FloatBorderDouble  FloatBorder = "double"
+	FloatBorderRounded FloatBorder = "rounded"
+	FloatBorderSolid   FloatBorder = "solid"
+	FloatBorderShadow  FloatBorder = "shadow"
+)
+
+type FloatStyle string
+
+const (
+	FloatStyleDefault FloatStyle = ""
+
+	// hide number, sign, fold and end-of-buffer columns
+	FloatStyleMinimal FloatStyle = "minimal"
+)
+
+type FloatConfig struct {
+	Relative FloatRelative
+	// Window is used with FloatRelativeWindow.
+	Window *Window
+	Anchor FloatAnchor
+	Row    float64
+	Col    float64
+	Width  int
+	Height int
+
+	Border FloatBorder
+	// BorderChars defines a custom border (see nvim_open_win()) and takes
+	// precedence over Border.
+	BorderChars []string
+	Title       string
+	ZIndex      int
+	// Focusable allows to move the cursor into the window, e.g. with <C-w>w. It
+	// defaults to false, unlike in nvim_open_win().
+	Focusable bool
+	Style     FloatStyle
+
+	// Enter focuses the window when it is opened.
+	Enter bool
+	// CloseOn closes the window when one of the events is triggered in the buffer
+	// that was current when the window was opened, e.g. EventCursorMoved or
+	// EventBufLeave.
+	CloseOn []string
+}
+
+func (c FloatConfig) options() map[string]interface{} {
+	opts := map[string]interface{}{
+		"relative":  string(c.Relative),
+		"row":       c.Row,
+		"col":       c.Col,
+		"width":     c.Width,
+		"height":    c.Height,
+		"focusable": c.Focusable,
+	}
+
+	if c.Relative == "" {
+		opts["relative"] = string(FloatRelativeEditor)
+	}
+	if c.Window != nil {
+		opts["win"] = c.Window.id
+	}
+	if c.Anchor != "" {
+		opts["anchor"] = string(c.Anchor)
+	}
+	if len(c.BorderChars) > 0 {
+		opts["border"] = c.BorderChars
+	} else if c.Border != "" {
+		opts["border"] = string(c.Border)
+	}
+	if c.Title != "" {
+		opts["title"] = c.Title
+	}
+	if c.ZIndex > 0 {
+		opts["zindex"] = c.ZIndex
+	}
+	if c.Style != FloatStyleDefault {
+		opts["style"] = string(c.Style)
+	}
+
+	return opts
+}
+
+// floatConfig is the normalized result of nvim_win_get_config().
+type floatConfig struct {
+	Relative  string   `msgpack:"relative"`
+	Window    int      `msgpack:"win"`
+	Anchor    string   `msgpack:"anchor"`
+	Row       float64  `msgpack:"row"`
+	Col       float64  `msgpack:"col"`
+	Width     int      `msgpack:"width"`
+	Height    int      `msgpack:"height"`
+	Border    []string `msgpack:"border"`
+	Title     string   `msgpack:"title"`
+	ZIndex    int      `msgpack:"zindex"`
+	Focusable bool     `msgpack:"focusable"`
+}
+
+// OpenFloat opens `b` in a new floating window.
+func (api *Api) OpenFloat(b *Buffer, config FloatConfig) (*Window, error) {
+	origin := api.CurrentBuffer()
+
+	var id nvim.Window
+	if err := api.nvim().Request("nvim_open_win", &id, b.id, config.Enter, config.options()); err != nil {
+		return nil, err
+	}
+
+	win := newWindowById(api, id)
+
+	if len(config.CloseOn) > 0 {
+		win.closeOn(origin, config.CloseOn)
+	}
+
+	return win, nil
+}
+
+func (w *Window) IsFloat() bool {
+	config, _ := w.api.nvim().WindowConfig(w.id)
+	return config != nil && config.Relative != ""
+}
+
+// Config returns the configuration of a floating window. Custom borders are
+// returned as BorderChars.
+func (w *Window) Config() FloatConfig {
+	var c floatConfig
+	w.api.nvim().ExecLua(`
+		local win = ...
+		local c = vim.api.nvim_win_get_config(win)
+
+		-- before nvim 0.10 row and col are returned as { [false] = value }
+		if type(c.row) == 'table' then c.row = c.row[false] end
+		if type(c.col) == 'table' then c.col = c.col[false] end
+
+		local border = {}
+		for _, b in ipairs(c.border or {}) do
+			table.insert(border, type(b) == 'table' and b[1] or b)
+		end
+		c.border = border
+
+		if type(c.title) == 'table' then
+			local title = ''
+			for _, chunk in ipairs(c.title) do title = title .. chunk[1] end
+			c.title = title
+		end
+
+		return c
+	`, &c, w.id)
+
+	config := FloatConfig{
+		Relative:    FloatRelative(c.Relative),
+		Anchor:      FloatAnchor(c.Anchor),
+		Row:         c.Row,
+		Col:         c.Col,
+		Width:       c.Width,
+		Height:      c.Height,
+		BorderChars: c.Border,
+		Title:       c.Title,
+		ZIndex:      c.ZIndex,
+		Focusable:   c.Focusable,
+	}
+	if c.Window > 0 {
+		config.Window = newWindowById(w.api, nvim.Window(c.Window))
+	}
+
+	return config
+}
+
+// SetConfig moves and resizes a floating window.
+func (w *Window) SetConfig(config FloatConfig) error {
+	opts := config.options()
+	// the style can only be set when the window is opened
+	delete(opts, "style")
+	return w.api.nvim().Request("nvim_win_set_config", nil, w.id, opts)
+}
+
+func (w *Window) closeOn(b *Buffer, events []string) {
+	var handler *HandlerFunc
+	var groupName string
+
+	handler = w.api.Handler.Create(func() {
+		w.Close(true)
+		w.api.Executef("autocmd! %s", groupName)
+		handler.Dispose()
+	})
+	groupName = fmt.Sprintf("float_%s", handler.uuid)
+
+	w.api.Executef(
+		"augroup %s | autocmd %s <buffer=%d> ++once call %s | augroup END",
+		groupName, strings.Join(events, ","), b.ID(), handler,
+	)
+}
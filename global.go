@@ -7,6 +7,8 @@ import (
 type Global struct {
 	api     *Api
 	Vars    Vars
+	VimVars Vars
+	Env     Vars
 	Options GlobalOptions
 	KeyMaps KeyMaps
 }
@@ -15,6 +17,8 @@ func newGlobal(api *Api) Global {
 	return Global{
 		api:     api,
 		Vars:    newGlobalVars(api),
+		VimVars: newVimVars(api),
+		Env:     newEnvVars(api),
 		Options: GlobalOptions{api},
 		KeyMaps: newGlobalKeyMaps(api),
 	}
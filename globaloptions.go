@@ -4,6 +4,11 @@ const (
 	GlobalOperatorFunc StringOption = "operatorfunc"
 	GlobalSelection    StringOption = "selection"
 	GlobalClipboard    StringOption = "clipboard"
+	GlobalColumns      IntOption    = "columns"
+	GlobalLines        IntOption    = "lines"
+	GlobalStatusLine   StringOption = "statusline"
+	GlobalWinBar       StringOption = "winbar"
+	GlobalTabLine      StringOption = "tabline"
 )
 
 // WindowOptionWidth
@@ -69,36 +74,64 @@ func (o GlobalOptions) SetClipboard(value GlobalClipboardValue) {
 	o.setString(GlobalClipboard, string(value))
 }
 
+// Number of columns of the screen.
+func (o GlobalOptions) Columns() int {
+	return o.getInt(GlobalColumns)
+}
+
+// Number of lines of the screen.
+func (o GlobalOptions) Lines() int {
+	return o.getInt(GlobalLines)
+}
+
+func (o GlobalOptions) StatusLine() string {
+	return o.getString(GlobalStatusLine)
+}
+
+func (o GlobalOptions) SetStatusLine(value string) {
+	o.setString(GlobalStatusLine, value)
+}
+
+func (o GlobalOptions) WinBar() string {
+	return o.getString(GlobalWinBar)
+}
+
+func (o GlobalOptions) SetWinBar(value string) {
+	o.setString(GlobalWinBar, value)
+}
+
+func (o GlobalOptions) TabLine() string {
+	return o.getString(GlobalTabLine)
+}
+
+func (o GlobalOptions) SetTabLine(value string) {
+	o.setString(GlobalTabLine, value)
+}
+
 // set selection=inclusive clipboard-=unnamed clipboard-=unnamedplus
 
 ////////////////////////////////////////////////////////////////////////////////
 
 func (o *GlobalOptions) getString(name StringOption) string {
-	var value string
-	o.api.nvim().Option(string(name), &value)
-	return value
+	return o.Values().String(name)
 }
 
 func (o *GlobalOptions) setString(name StringOption, value string) {
-	o.api.nvim().SetOption(string(name), value)
+	o.Values().SetString(name, value)
 }
 
 func (o *GlobalOptions) getBool(name BoolOption) bool {
-	var value bool
-	o.api.nvim().Option(string(name), &value)
-	return value
+	return o.Values().Bool(name)
 }
 
 func (o *GlobalOptions) setBool(name BoolOption, value bool) {
-	o.api.nvim().SetOption(string(name), value)
+	o.Values().SetBool(name, value)
 }
 
 func (o *GlobalOptions) getInt(name IntOption) int {
-	var value int
-	o.api.nvim().Option(string(name), &value)
-	return value
+	return o.Values().Int(name)
 }
 
 func (o *GlobalOptions) setInt(name IntOption, value int) {
-	o.api.nvim().SetOption(string(name), value)
+	o.Values().SetInt(name, value)
 }
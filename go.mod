@@ -1,5 +1,5 @@
 module github.com/josa42/go-neovim
 
-go 1.16
+go 1.18
 
 require github.com/neovim/go-client v1.1.7
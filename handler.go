@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 
 	"github.com/josa42/go-neovim/disposables"
 )
@@ -31,10 +32,12 @@ func (h *HandlerFunc) Dispose() {
 }
 
 type Handler struct {
-	api          *Api
-	uuid         string
-	handlers     map[string]func([]interface{})
-	operatorFunc func(args []interface{})
+	api      *Api
+	uuid     string
+	handlers map[string]func([]interface{})
+	mutex    *sync.Mutex
+	// release channels of the pending calls, by the goroutine running the handler
+	pending map[uint64]chan struct{}
 }
 
 func newHandler(api *Api) Handler {
@@ -42,6 +45,7 @@ func newHandler(api *Api) Handler {
 		api:      api,
 		uuid:     readHandlerUUID(),
 		handlers: map[string]func([]interface{}){},
+		mutex:    &sync.Mutex{},
 	}
 
 	// go func() {
@@ -62,74 +66,83 @@ func newHandler(api *Api) Handler {
 
 func (h *Handler) register(api *Api) {
 	api.Function(h.functionName(), func(args []interface{}) error {
-		defer func() {
-			if err := recover(); err != nil {
-				log.Printf("GlobalHandler() recover: %v\n", err)
-			}
-		}()
 		if len(args) > 0 {
 			if hID, ok := args[0].(string); ok {
-				if hndl, ok := h.handlers[hID]; ok {
-					hndl(args[1:])
+				if hndl, ok := h.get(hID); ok {
+					h.call(hndl, args[1:])
 					return nil
 				}
 			}
 		}
 		return nil
 	})
+}
 
-	api.Function(h.operatorFunctionName(), func(args []interface{}) error {
-		defer func() {
-			if err := recover(); err != nil {
-				log.Printf("OperatorFunc() recover: %v\n", err)
-			}
-		}()
-
-		if h.operatorFunc != nil {
-			h.operatorFunc(args)
+// call runs `hndl`, neovim waits until it returns.
+func (h *Handler) call(hndl func([]interface{}), args []interface{}) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("GlobalHandler() recover: %v\n", err)
 		}
-		return nil
-	})
+	}()
+	hndl(args)
+}
+
+func (h *Handler) get(uuid string) (func([]interface{}), bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	hndl, ok := h.handlers[uuid]
+	return hndl, ok
 }
 
 func (h *Handler) Create(fn interface{}) *HandlerFunc {
 	uuid := generateUUID()
 
+	var hndl func([]interface{})
 	if fnh, ok := fn.(func()); ok {
-		h.handlers[uuid] = func([]interface{}) {
+		hndl = func([]interface{}) {
 			fnh()
 		}
 	} else if fnh, ok := fn.(func(args ...interface{})); ok {
-		h.handlers[uuid] = func(args []interface{}) {
+		hndl = func(args []interface{}) {
 			fnh(args...)
 		}
 	} else {
 		panic("invalid handler")
 	}
 
+	h.mutex.Lock()
+	h.handlers[uuid] = hndl
+	h.mutex.Unlock()
+
 	return &HandlerFunc{
 		uuid:         uuid,
 		functionName: h.functionName(),
 		disposeFn: func() {
+			h.mutex.Lock()
 			delete(h.handlers, uuid)
+			h.mutex.Unlock()
 		},
 	}
 }
 
+// SetOperatorFunc sets 'operatorfunc' to call `fn` with the motion type.
+//
+// Deprecated: use KeyMaps.SetOperator(), which maps the keys as well and
+// supports several operators at a time.
 func (h *Handler) SetOperatorFunc(fn func(args []interface{})) disposables.Disposable {
-	h.operatorFunc = fn
-	h.api.Global.Options.SetOperatorFunc(h.operatorFunctionName())
+	handler := h.Create(func(args ...interface{}) {
+		fn(args)
+	})
+	h.api.Global.Options.SetOperatorFunc(fmt.Sprintf(`{motion -> %s}`, handler.StringWithEvals("motion")))
 
 	return disposables.New(func() {
 		h.api.Global.Options.SetOperatorFunc("")
-		h.operatorFunc = nil
+		handler.Dispose()
 	})
 }
 
-func (h *Handler) operatorFunctionName() string {
-	return fmt.Sprintf(`OperatorFunc_%s`, h.uuid)
-}
-
 func (h *Handler) functionName() string {
 	return fmt.Sprintf(`Handler_%s`, h.uuid)
 }
@@ -0,0 +1,33 @@
+package neovim
+
+// Namespace returns the id of the namespace `name`, creating it if needed.
+// Namespaces group highlights and extmarks.
+func (api *Api) Namespace(name string) int {
+	id, _ := api.nvim().CreateNamespace(name)
+	return id
+}
+
+type Highlight struct {
+	Group string
+	// Line is 1-based, StartCol and EndCol are 0-based byte indices. An EndCol of
+	// -1 highlights to the end of the line.
+	Line     int
+	StartCol int
+	EndCol   int
+}
+
+// AddHighlights adds highlights to the buffer in one request.
+func (b *Buffer) AddHighlights(namespace int, highlights []Highlight) {
+	ids := make([]int, len(highlights))
+
+	batch := b.api.nvim().NewBatch()
+	for i, h := range highlights {
+		batch.AddBufferHighlight(b.id, namespace, h.Group, h.Line-1, h.StartCol, h.EndCol, &ids[i])
+	}
+	batch.Execute()
+}
+
+// ClearHighlights removes the highlights of `namespace` from the buffer.
+func (b *Buffer) ClearHighlights(namespace int) {
+	b.api.nvim().ClearBufferNamespace(b.id, namespace, 0, -1)
+}
@@ -0,0 +1,175 @@
+package neovim
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type KeyMapConflictPolicy int
+
+const (
+	// use the policy of the KeyMapRegistry
+	KeyMapConflictDefault KeyMapConflictPolicy = iota
+
+	// create the mapping and show a warning
+	KeyMapConflictWarn
+
+	// keep the existing mapping, KeyMaps.Map() returns a *KeyMapConflictError
+	KeyMapConflictSkip
+
+	// replace the existing mapping silently
+	KeyMapConflictOverride
+)
+
+// KeyMapEntry is a mapping created through KeyMaps by any go-neovim plugin.
+type KeyMapEntry struct {
+	Plugin string `msgpack:"plugin"`
+	Mode   Mode   `msgpack:"mode"`
+	LHS    string `msgpack:"lhs"`
+	Desc   string `msgpack:"desc"`
+
+	// Buffer is the buffer of a buffer-local mapping, or 0.
+	Buffer int `msgpack:"buffer"`
+}
+
+// KeyMapConflictError describes an existing mapping that conflicts with a new
+// one.
+type KeyMapConflictError struct {
+	Mode     Mode
+	LHS      string
+	Existing KeyMap
+
+	// Plugin created the existing mapping. It is empty for mappings that have
+	// not been created with go-neovim, e.g. by the user.
+	Plugin string
+}
+
+func (e *KeyMapConflictError) Error() string {
+	owner := "an existing mapping"
+	if e.Plugin != "" {
+		owner = fmt.Sprintf("a mapping of %s", e.Plugin)
+	}
+	return fmt.Sprintf("mapping %s (mode %q) conflicts with %s", e.LHS, e.Mode, owner)
+}
+
+// KeyMapRegistry keeps track of the mappings of all go-neovim plugins, to
+// detect conflicts and to show them in a help view.
+type KeyMapRegistry struct {
+	api *Api
+
+	// Plugin is the name the mappings are registered with. It defaults to the
+	// name of the executable.
+	Plugin string
+
+	// Policy for conflicts with existing mappings, it defaults to
+	// KeyMapConflictWarn. Mappings of the plugin itself are always replaced.
+	Policy KeyMapConflictPolicy
+}
+
+func newKeyMapRegistry(api *Api) KeyMapRegistry {
+	return KeyMapRegistry{
+		api:    api,
+		Plugin: filepath.Base(os.Args[0]),
+		Policy: KeyMapConflictWarn,
+	}
+}
+
+const keyMapRegistryListLua = `
+	local R = _G.GoNeovimKeyMapRegistry or {}
+	local entries = {}
+	for id, e in pairs(R) do
+		if e.buffer ~= 0 and not vim.api.nvim_buf_is_valid(e.buffer) then
+			R[id] = nil
+		else
+			table.insert(entries, { plugin = e.plugin, mode = e.mode == ' ' and '' or e.mode, lhs = e.lhs, desc = e.desc, buffer = e.buffer })
+		end
+	end
+	return entries
+`
+
+const keyMapRegistryOwnerLua = `
+	local buf, mode, key = ...
+	for _, e in pairs(_G.GoNeovimKeyMapRegistry or {}) do
+		if e.buffer == buf and e.mode == mode and e.key == key then
+			return e.plugin
+		end
+	end
+	return ''
+`
+
+// List returns the mappings of all go-neovim plugins, sorted by plugin, mode
+// and LHS.
+func (r *KeyMapRegistry) List() []KeyMapEntry {
+	entries := []KeyMapEntry{}
+	r.api.nvim().ExecLua(keyMapRegistryListLua, &entries)
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Plugin != b.Plugin {
+			return a.Plugin < b.Plugin
+		}
+		if a.Mode != b.Mode {
+			return a.Mode < b.Mode
+		}
+		return a.LHS < b.LHS
+	})
+
+	return entries
+}
+
+func (r *KeyMapRegistry) entry(m KeyMaps, mode Mode, lhs string, desc string) map[string]interface{} {
+	return map[string]interface{}{
+		"plugin": r.Plugin,
+		"mode":   string(mode),
+		"lhs":    lhs,
+		"desc":   desc,
+		"buffer": int(m.buffer),
+	}
+}
+
+func (r *KeyMapRegistry) policy(p KeyMapConflictPolicy) KeyMapConflictPolicy {
+	if p == KeyMapConflictDefault {
+		p = r.Policy
+	}
+	if p == KeyMapConflictDefault {
+		p = KeyMapConflictWarn
+	}
+	return p
+}
+
+// conflict returns the existing mapping `lhs` in `mode`, unless it has been
+// created by this plugin.
+func (r *KeyMapRegistry) conflict(m KeyMaps, mode Mode, lhs string) *KeyMapConflictError {
+	existing := m.get(mode)
+	if len(existing) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(existing)+1)
+	batch := r.api.nvim().NewBatch()
+	batch.ReplaceTermcodes(lhs, true, true, true, &keys[0])
+	for i, e := range existing {
+		batch.ReplaceTermcodes(e.LHS, true, true, true, &keys[i+1])
+	}
+	if err := batch.Execute(); err != nil {
+		return nil
+	}
+
+	for i, e := range existing {
+		if keys[i+1] != keys[0] {
+			continue
+		}
+
+		var plugin string
+		r.api.nvim().ExecLua(keyMapRegistryOwnerLua, &plugin, int(m.buffer), string(e.Mode), keys[0])
+		if plugin == r.Plugin {
+			return nil
+		}
+
+		return &KeyMapConflictError{Mode: mode, LHS: lhs, Existing: e, Plugin: plugin}
+	}
+
+	return nil
+}
@@ -2,29 +2,21 @@ package neovim
 
 import (
 	"fmt"
-	"log"
-	"regexp"
-	"strings"
 
+	"github.com/josa42/go-neovim/disposables"
 	"github.com/neovim/go-client/nvim"
 )
 
 type KeyMaps struct {
 	api    *Api
-	set    func(mode Mode, lhs string, rhs string, opts map[string]bool) error
-	get    func(mode Mode) ([]*nvim.Mapping, error)
+	buffer nvim.Buffer
 	delete func(mode Mode, lhs string)
 }
 
 func newBufferKeyMaps(api *Api, id nvim.Buffer) KeyMaps {
 	return KeyMaps{
-		api: api,
-		set: func(mode Mode, lhs string, rhs string, opts map[string]bool) error {
-			return api.nvim().SetBufferKeyMap(id, string(mode), lhs, rhs, opts)
-		},
-		get: func(mode Mode) ([]*nvim.Mapping, error) {
-			return api.nvim().BufferKeyMap(id, string(mode))
-		},
+		api:    api,
+		buffer: id,
 		delete: func(mode Mode, lhs string) {
 			api.nvim().DeleteBufferKeyMap(id, string(mode), lhs)
 		},
@@ -34,132 +26,270 @@ func newBufferKeyMaps(api *Api, id nvim.Buffer) KeyMaps {
 func newGlobalKeyMaps(api *Api) KeyMaps {
 	return KeyMaps{
 		api: api,
-		set: func(mode Mode, lhs string, rhs string, opts map[string]bool) error {
-			return api.nvim().SetKeyMap(string(mode), lhs, rhs, opts)
-		},
-		get: func(mode Mode) ([]*nvim.Mapping, error) {
-			return api.nvim().KeyMap(string(mode))
-		},
 		delete: func(mode Mode, lhs string) {
 			api.nvim().DeleteKeyMap(string(mode), lhs)
 		},
 	}
 }
 
-func (m *KeyMaps) SetFunc(mode Mode, keys string, fn func()) {
-	handler := m.api.Handler.Create(fn)
-	eval := fmt.Sprintf(`:silent call %s<CR>`, handler)
-	m.set(mode, keys, eval, map[string]bool{"silent": true, "nowait": true})
-}
-
-func (m *KeyMaps) SetTextAction(keys string, fn func(string) string) {
-	defer func() {
-		if err := recover(); err != nil {
-			log.Printf("error: %v", err)
-		}
-	}()
-
-	h := m.textActionHandler(fn)
-
-	m.Setf(ModeNormal, keys, `:<C-U>silent call %s<CR>g@`, m.createMotionActionHandler(h))
-	m.Setf(Mode("x"), keys, `:<C-U>silent call %s<CR>`, m.createSelectionActionHandler(h))
-}
-
-func (m KeyMaps) Set(mode Mode, keys string, eval string) {
-	m.set(mode, keys, eval, map[string]bool{"silent": true, "nowait": true})
+// KeyMap is a mapping returned by KeyMaps.List().
+type KeyMap struct {
+	Mode    Mode   `msgpack:"mode"`
+	LHS     string `msgpack:"lhs"`
+	RHS     string `msgpack:"rhs"`
+	Desc    string `msgpack:"desc"`
+	Noremap bool   `msgpack:"noremap"`
+	Silent  bool   `msgpack:"silent"`
+	Expr    bool   `msgpack:"expr"`
+	Nowait  bool   `msgpack:"nowait"`
+	Buffer  bool   `msgpack:"buffer"`
+
+	// Callback is set for mappings with a Lua function instead of a RHS.
+	Callback bool `msgpack:"callback"`
 }
 
-func (m KeyMaps) Setf(mode Mode, keys string, eval string, args ...interface{}) {
-	m.set(mode, keys, fmt.Sprintf(eval, args...), map[string]bool{"silent": true, "nowait": true})
+type KeyMapOptions struct {
+	// the RHS is an expression, that evaluates to the keys to execute
+	Expr bool
+	// the RHS is not remapped
+	Noremap bool
+	Desc    string
+	Silent  bool
+	// do not wait for longer mappings with the same prefix
+	Nowait bool
+	// fail if the mapping exists already
+	Unique bool
+	// what to do if the mapping exists already, see KeyMapRegistry
+	Conflict KeyMapConflictPolicy
 }
 
-func (m KeyMaps) Delete(mode Mode, keys string) {
-	m.delete(mode, keys)
-}
-
-func (m KeyMaps) Disable(mode Mode, keys string) {
-	m.set(mode, keys, "<nop>", map[string]bool{"silent": true, "nowait": true})
-}
-
-func (m *KeyMaps) createSelectionActionHandler(fn func(...interface{})) string {
-	return m.api.Handler.Create(fn).StringWithEvals("visualmode()")
+func (o KeyMapOptions) options() map[string]interface{} {
+	opts := map[string]interface{}{
+		"expr":    o.Expr,
+		"noremap": o.Noremap,
+		"silent":  o.Silent,
+		"nowait":  o.Nowait,
+	}
+	if o.Desc != "" {
+		opts["desc"] = o.Desc
+	}
+	if o.Unique {
+		opts["unique"] = true
+	}
+	return opts
 }
 
-func (m *KeyMaps) createMotionActionHandler(fn func(...interface{})) string {
-	return m.api.Handler.Create(func() {
-		m.api.Handler.SetOperatorFunc(func(args []interface{}) {
-			fn(args...)
+var defaultKeyMapOptions = KeyMapOptions{Silent: true, Nowait: true}
+
+const keyMapsListLua = `
+	local buf, mode = ...
+	local maps = buf == 0 and vim.api.nvim_get_keymap(mode) or vim.api.nvim_buf_get_keymap(buf, mode)
+
+	local result = {}
+	for _, m in ipairs(maps) do
+		table.insert(result, {
+			mode = m.mode,
+			lhs = m.lhs,
+			rhs = m.rhs or '',
+			desc = m.desc or '',
+			noremap = m.noremap == 1,
+			silent = m.silent == 1,
+			expr = m.expr == 1,
+			nowait = m.nowait == 1,
+			buffer = m.buffer ~= 0,
+			callback = m.callback ~= nil,
 		})
-	}).String()
-}
-
-// Taken from https://github.com/arthurxavierx/vim-caser/blob/master/plugin/caser.vim#L17
-func (m KeyMaps) textActionHandler(fn func(string) string) func(typi ...interface{}) {
-
-	return func(typi ...interface{}) {
-
-		// backup settings that we will change
-		opt := m.api.Global.Options
-		selection := opt.Selection()
-		clipboard := opt.Clipboard()
-
-		// make selection and clipboard work the way we need
-		opt.SetSelection(GlobalSelectionInclusive)
-		opt.SetClipboard(GlobalClipboardNone)
-
-		// backup the unnamed register, which we will be yanking into
-		regBak, _ := m.api.Execute("echo @@")
-
-		// restore saved settings and register value
-		defer func() {
-			opt.SetSelection(selection)
-			opt.SetClipboard(clipboard)
+	end
+	return result
+`
+
+const keyMapsMapLua = `
+	local id, buf, mode, lhs, rhs, opts, entry = ...
+	_G.GoNeovimKeyMaps = _G.GoNeovimKeyMaps or {}
+	_G.GoNeovimKeyMapRegistry = _G.GoNeovimKeyMapRegistry or {}
+
+	local key = vim.api.nvim_replace_termcodes(lhs, true, true, true)
+	local mapmode = mode == '' and ' ' or mode
+	local function find()
+		local maps = buf == 0 and vim.api.nvim_get_keymap(mode) or vim.api.nvim_buf_get_keymap(buf, mode)
+		for _, m in ipairs(maps) do
+			if m.mode == mapmode and vim.api.nvim_replace_termcodes(m.lhs, true, true, true) == key then
+				return m
+			end
+		end
+	end
+
+	local prev = find()
+
+	local ok, err
+	if buf == 0 then
+		ok, err = pcall(vim.api.nvim_set_keymap, mode, lhs, rhs, opts)
+	else
+		ok, err = pcall(vim.api.nvim_buf_set_keymap, buf, mode, lhs, rhs, opts)
+	end
+	if not ok then
+		error(err, 0)
+	end
+
+	-- remember what this mapping looks like, to recognize it when disposing
+	local own = find() or {}
+	_G.GoNeovimKeyMaps[id] = { buf = buf, mode = mapmode, key = key, prev = prev, rhs = own.rhs, desc = own.desc }
+
+	-- the new mapping replaces the ones registered for the same key
+	entry.key, entry.mode = key, mapmode
+	for i, e in pairs(_G.GoNeovimKeyMapRegistry) do
+		if e.buffer == entry.buffer and e.mode == mapmode and e.key == key then
+			_G.GoNeovimKeyMapRegistry[i] = nil
+		end
+	end
+	_G.GoNeovimKeyMapRegistry[id] = entry
+`
+
+const keyMapsUnmapLua = `
+	local id, buf, mode, lhs = ...
+	local s = (_G.GoNeovimKeyMaps or {})[id]
+	_G.GoNeovimKeyMaps[id] = nil
+	_G.GoNeovimKeyMapRegistry[id] = nil
+	if not s then
+		return
+	end
+
+	local cur
+	local maps = buf == 0 and vim.api.nvim_get_keymap(mode) or vim.api.nvim_buf_get_keymap(buf, mode)
+	for _, m in ipairs(maps) do
+		if m.mode == s.mode and vim.api.nvim_replace_termcodes(m.lhs, true, true, true) == s.key then
+			cur = m
+		end
+	end
+
+	if not cur or cur.rhs ~= s.rhs or cur.desc ~= s.desc then
+		-- the mapping has been replaced since: keep the current one, and let
+		-- the mapping that replaced this one restore what this one replaced
+		for _, o in pairs(_G.GoNeovimKeyMaps) do
+			if o.buf == s.buf and o.mode == s.mode and o.key == s.key and o.prev
+				and o.prev.rhs == s.rhs and o.prev.desc == s.desc then
+				o.prev = s.prev
+			end
+		end
+		return
+	end
+
+	if buf == 0 then
+		pcall(vim.api.nvim_del_keymap, mode, lhs)
+	else
+		pcall(vim.api.nvim_buf_del_keymap, buf, mode, lhs)
+	end
+
+	local m = s.prev
+	if m then
+		local opts = {
+			noremap = m.noremap == 1,
+			silent = m.silent == 1,
+			expr = m.expr == 1,
+			nowait = m.nowait == 1,
+			script = m.script == 1,
+			desc = m.desc,
+			callback = m.callback,
+		}
+		local mode = m.mode == ' ' and '' or m.mode
+		if buf == 0 then
+			pcall(vim.api.nvim_set_keymap, mode, m.lhs, m.rhs or '', opts)
+		else
+			pcall(vim.api.nvim_buf_set_keymap, buf, mode, m.lhs, m.rhs or '', opts)
+		end
+	end
+`
+
+// Map maps `lhs` to `rhs` in `mode`. `rhs` is either a string or a func(),
+// which is called with <Cmd>; Expr only applies to strings. Disposing the
+// mapping deletes it and restores the mapping it replaced, if there was one.
+// If the mapping has been replaced in the meantime, it is left in place.
+//
+// Conflicts with existing mappings are handled according to opts.Conflict.
+func (m KeyMaps) Map(mode Mode, lhs string, rhs interface{}, opts KeyMapOptions) (disposables.Disposable, error) {
+	registry := &m.api.KeyMapRegistry
+
+	if policy := registry.policy(opts.Conflict); policy != KeyMapConflictOverride && !opts.Unique {
+		if err := registry.conflict(m, mode, lhs); err != nil {
+			if policy == KeyMapConflictSkip {
+				return nil, err
+			}
+			m.api.Out.Warn(err.Error())
+		}
+	}
 
-			m.api.Executef("let @@ = '%s'", strings.Replace(regBak, "'", `'."'".'`, -1))
-		}()
+	var handler *HandlerFunc
 
-		//
+	switch r := rhs.(type) {
+	case string:
+	case func():
+		handler = m.api.Handler.Create(r)
+		rhs = fmt.Sprintf(`<Cmd>call %s<CR>`, handler)
+		opts.Expr = false
+	default:
+		panic("invalid rhs")
+	}
 
-		typ := ""
-		if len(typi) != 1 {
-			return
+	id := generateUUID()
+	if err := m.api.nvim().ExecLua(keyMapsMapLua, nil, id, m.buffer, mode, lhs, rhs, opts.options(), registry.entry(m, mode, lhs, opts.Desc)); err != nil {
+		if handler != nil {
+			handler.Dispose()
 		}
+		return nil, err
+	}
 
-		typ, _ = typi[0].(string)
+	return disposables.New(func() {
+		if handler != nil {
+			handler.Dispose()
+		}
+		m.api.nvim().ExecLua(keyMapsUnmapLua, nil, id, m.buffer, mode, lhs)
+	}), nil
+}
 
-		sel := ""
-		if regexp.MustCompile(`^\d+$`).MatchString(typ) {
-			// if type is a number, then select that many lines
-			sel = fmt.Sprintf("V%s$y", typ)
+// List returns the mappings of `mode`.
+func (m KeyMaps) List(mode Mode) []KeyMap {
+	return m.get(mode)
+}
 
-		} else if len(typ) == 1 {
-			// if type is 'v', 'V', or '<C-V>' (i.e. 0x16) then reselect the visual region
-			sel = fmt.Sprintf("`<%s`>y", typ)
+func (m KeyMaps) get(mode Mode) []KeyMap {
+	maps := []KeyMap{}
+	m.api.nvim().ExecLua(keyMapsListLua, &maps, m.buffer, mode)
+	return maps
+}
 
-		} else if typ == "line" {
-			// line-based text motion
-			sel = "'[V']y"
+// orNoop returns a disposable that does nothing if the mapping has not been
+// created, for the methods that do not return the error of Map().
+func orNoop(d disposables.Disposable, err error) disposables.Disposable {
+	if err != nil {
+		return disposables.New(func() {})
+	}
+	return d
+}
 
-		} else if typ == "line" {
-			// block-based text motion
-			sel = "`[\\<C-V>`]y"
+func (m *KeyMaps) SetFunc(mode Mode, keys string, fn func()) disposables.Disposable {
+	return orNoop(m.Map(mode, keys, fn, defaultKeyMapOptions))
+}
 
-		} else { // == "char"
-			// char-based text motion
-			sel = "`[v`]y"
-		}
+// SetTextAction maps `keys` to an operator that replaces the text of the motion
+// or the visual selection with the result of `fn`.
+func (m *KeyMaps) SetTextAction(keys string, fn func(string) string) disposables.Disposable {
+	return m.SetOperator(keys, func(ctx OperatorContext) {
+		ctx.SetText(fn(ctx.Text()))
+	})
+}
 
-		m.api.Executef("normal! %s", sel)
-		content, _ := m.api.Execute("echo @@")
+func (m KeyMaps) Set(mode Mode, keys string, eval string) disposables.Disposable {
+	return orNoop(m.Map(mode, keys, eval, defaultKeyMapOptions))
+}
 
-		replacement := fn(content)
+func (m KeyMaps) Setf(mode Mode, keys string, eval string, args ...interface{}) disposables.Disposable {
+	return orNoop(m.Map(mode, keys, fmt.Sprintf(eval, args...), defaultKeyMapOptions))
+}
 
-		// put the replacement text into the unnamed register, and also set it to be a
-		// characterwise, linewise, or blockwise selection, based upon the selection type of the
-		// yank we did above
-		m.api.Executef("call setreg('@', '%s', getregtype('@'))", strings.Replace(replacement, "'", `'."'".'`, -1))
+func (m KeyMaps) Delete(mode Mode, keys string) {
+	m.delete(mode, keys)
+}
 
-		// reselect the visual region and paste
-		m.api.Executef("normal! gvp")
-	}
+func (m KeyMaps) Disable(mode Mode, keys string) disposables.Disposable {
+	return orNoop(m.Map(mode, keys, "<nop>", defaultKeyMapOptions))
 }
@@ -0,0 +1,199 @@
+package neovim
+
+import (
+	"fmt"
+
+	"github.com/neovim/go-client/nvim"
+)
+
+type Direction int
+
+const (
+	DirectionUp Direction = iota
+	DirectionDown
+	DirectionLeft
+	DirectionRight
+)
+
+func (d Direction) splitCommand(size int) string {
+	s := ""
+	if size > 0 {
+		s = fmt.Sprint(size)
+	}
+
+	switch d {
+	case DirectionUp:
+		return fmt.Sprintf("aboveleft %ssplit", s)
+	case DirectionLeft:
+		return fmt.Sprintf("aboveleft %svsplit", s)
+	case DirectionRight:
+		return fmt.Sprintf("belowright %svsplit", s)
+	default:
+		return fmt.Sprintf("belowright %ssplit", s)
+	}
+}
+
+func (d Direction) moveCommand() string {
+	switch d {
+	case DirectionUp:
+		return "wincmd K"
+	case DirectionLeft:
+		return "wincmd H"
+	case DirectionRight:
+		return "wincmd L"
+	default:
+		return "wincmd J"
+	}
+}
+
+// Split splits the window in `direction` and shows `b` in the new window. A
+// `size` of 0 splits the window in half, a nil buffer shows the buffer of `w`.
+// The focus stays in the current window.
+func (w *Window) Split(direction Direction, size int, b *Buffer) *Window {
+	var bufnr interface{}
+	if b != nil {
+		bufnr = b.id
+	}
+
+	var id int
+	w.api.nvim().ExecLua(`
+		local win, cmd, buf = ...
+		local new
+		vim.api.nvim_win_call(win, function()
+			vim.cmd(cmd)
+			new = vim.api.nvim_get_current_win()
+			if buf then
+				vim.api.nvim_win_set_buf(new, buf)
+			end
+		end)
+		return new
+	`, &id, w.id, direction.splitCommand(size), bufnr)
+
+	return newWindowById(w.api, nvim.Window(id))
+}
+
+func (w *Window) Width() int {
+	width, _ := w.api.nvim().WindowWidth(w.id)
+	return width
+}
+
+func (w *Window) SetWidth(width int) {
+	w.api.nvim().SetWindowWidth(w.id, width)
+}
+
+func (w *Window) Height() int {
+	height, _ := w.api.nvim().WindowHeight(w.id)
+	return height
+}
+
+func (w *Window) SetHeight(height int) {
+	w.api.nvim().SetWindowHeight(w.id, height)
+}
+
+// Move moves the window to the very top, bottom, left or right of the tab, using
+// the full width or height.
+func (w *Window) Move(direction Direction) {
+	w.call(direction.moveCommand())
+}
+
+// Swap exchanges the buffers and cursor positions of `w` and `other`.
+func (w *Window) Swap(other *Window) {
+	w.api.nvim().ExecLua(`
+		local a, b = ...
+		local bufA, bufB = vim.api.nvim_win_get_buf(a), vim.api.nvim_win_get_buf(b)
+		local curA, curB = vim.api.nvim_win_get_cursor(a), vim.api.nvim_win_get_cursor(b)
+
+		vim.api.nvim_win_set_buf(a, bufB)
+		vim.api.nvim_win_set_buf(b, bufA)
+		vim.api.nvim_win_set_cursor(a, curB)
+		vim.api.nvim_win_set_cursor(b, curA)
+	`, nil, w.id, other.id)
+}
+
+// call runs the ex command `cmd` with `w` as current window, without changing
+// the focus.
+func (w *Window) call(cmd string) error {
+	return w.api.nvim().ExecLua(`
+		local win, cmd = ...
+		vim.api.nvim_win_call(win, function()
+			vim.cmd(cmd)
+		end)
+	`, nil, w.id, cmd)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Tab
+
+type LayoutType string
+
+const (
+	LayoutLeaf   LayoutType = "leaf"
+	LayoutRow    LayoutType = "row"
+	LayoutColumn LayoutType = "col"
+)
+
+// Layout is a node of the window layout tree of a tab (see winlayout()). Leafs
+// hold a Window, rows and columns hold Children.
+type Layout struct {
+	Type     LayoutType
+	Window   *Window
+	Children []Layout
+}
+
+func (t *Tab) Layout() Layout {
+	number, _ := t.api.nvim().TabpageNumber(t.id)
+
+	var raw []interface{}
+	t.api.nvim().Call("winlayout", &raw, number)
+
+	return parseLayout(t.api, raw)
+}
+
+func parseLayout(api *Api, raw []interface{}) Layout {
+	if len(raw) != 2 {
+		return Layout{}
+	}
+
+	typ, _ := raw[0].(string)
+	layout := Layout{Type: LayoutType(typ)}
+
+	switch layout.Type {
+	case LayoutLeaf:
+		if id, ok := toInt(raw[1]); ok {
+			layout.Window = newWindowById(api, nvim.Window(id))
+		}
+
+	case LayoutRow, LayoutColumn:
+		children, _ := raw[1].([]interface{})
+		for _, c := range children {
+			if child, ok := c.([]interface{}); ok {
+				layout.Children = append(layout.Children, parseLayout(api, child))
+			}
+		}
+	}
+
+	return layout
+}
+
+// Windows returns the windows of the layout, from top left to bottom right.
+func (l Layout) Windows() []*Window {
+	if l.Type == LayoutLeaf {
+		if l.Window == nil {
+			return []*Window{}
+		}
+		return []*Window{l.Window}
+	}
+
+	windows := []*Window{}
+	for _, c := range l.Children {
+		windows = append(windows, c.Windows()...)
+	}
+	return windows
+}
+
+// Equalize makes all windows of the tab (almost) the same size.
+func (t *Tab) Equalize() {
+	if windows := t.Windows(); len(windows) > 0 {
+		windows[0].call("wincmd =")
+	}
+}
@@ -0,0 +1,288 @@
+package neovim
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/josa42/go-neovim/disposables"
+	"github.com/neovim/go-client/nvim"
+)
+
+type MotionType string
+
+const (
+	MotionChar  MotionType = "char"
+	MotionLine  MotionType = "line"
+	MotionBlock MotionType = "block"
+)
+
+// OperatorContext describes the text an operator is applied to.
+type OperatorContext struct {
+	// Range of the motion or the visual selection. Linewise ranges end at column
+	// 0 of the line after the last line. Blockwise ranges start at the block on
+	// the first line and end after the block on the last line.
+	Range  Range
+	Motion MotionType
+
+	// Count is 0 if no count has been typed.
+	Count    int
+	Register string
+
+	Buffer *Buffer
+	Window *Window
+
+	// Visual is set if the operator has been applied to a visual selection.
+	Visual bool
+
+	// virtual columns of a blockwise motion (1-based, inclusive), the right one
+	// is -1 if the block extends to the end of the lines
+	vcols [2]int
+}
+
+const operatorLua = `
+	_G.GoNeovimOperator = _G.GoNeovimOperator or { operators = {} }
+	local O = _G.GoNeovimOperator
+
+	-- exclusive end of the character at col, or the end of the line
+	function O.endcol(buf, lnum, col)
+		local text = vim.api.nvim_buf_get_lines(buf, lnum - 1, lnum, true)[1]
+		if col < 0 or col >= #text then
+			return #text
+		end
+		return col + #vim.fn.strpart(text, col, 1, true)
+	end
+
+	-- first and last virtual column of the character at col
+	local function vcols(lnum, col)
+		local text = vim.api.nvim_buf_get_lines(0, lnum - 1, lnum, true)[1]
+		local e = O.endcol(0, lnum, col)
+		return vim.fn.strdisplaywidth(text:sub(1, col)) + 1, vim.fn.strdisplaywidth(text:sub(1, e))
+	end
+
+	-- byte range of the virtual columns vleft to vright in text, characters
+	-- partially in the block are included
+	function O.span(text, vleft, vright)
+		local sc, ec = nil, #text
+		local i = 0
+		while i < #text do
+			local n = #vim.fn.strpart(text, i, 1, true)
+			if vright >= 0 and vim.fn.strdisplaywidth(text:sub(1, i)) >= vright then
+				ec = i
+				break
+			end
+			if not sc and vim.fn.strdisplaywidth(text:sub(1, i + n)) >= vleft then
+				sc = i
+			end
+			i = i + n
+		end
+		sc = sc or #text
+		return sc, math.max(sc, ec)
+	end
+
+	-- s and e are the (inclusive) positions of the marks
+	local function call(id, motion, s, e, count, register, visual, vright)
+		local op = O.operators[id]
+		if not op then
+			return
+		end
+
+		local v = { 0, 0 }
+		if motion == 'line' then
+			s, e = { s[1], 0 }, { e[1] + 1, 0 }
+		elseif motion == 'block' then
+			local ls, rs = vcols(s[1], s[2])
+			local le, re = vcols(e[1], e[2])
+			v = { math.min(ls, le), vright or math.max(rs, re) }
+
+			local first = vim.api.nvim_buf_get_lines(0, s[1] - 1, s[1], true)[1]
+			local last = vim.api.nvim_buf_get_lines(0, e[1] - 1, e[1], true)[1]
+			s = { s[1], (O.span(first, v[1], v[2])) }
+			e = { e[1], select(2, O.span(last, v[1], v[2])) }
+		else
+			e = { e[1], O.endcol(0, e[1], e[2]) }
+		end
+
+		vim.fn[op.fn](op.hid, motion, s[1], s[2], e[1], e[2], count, register,
+			vim.api.nvim_get_current_buf(), vim.api.nvim_get_current_win(), visual, v[1], v[2])
+	end
+
+	-- called by the expr mapping in normal mode. The operator, count and
+	-- register are part of 'operatorfunc', so that "." repeats this operator
+	-- without calling setup again.
+	function O.setup(id)
+		vim.go.operatorfunc = ('{motion -> v:lua.GoNeovimOperator.run(motion, %s, %d, %s)}'):format(
+			vim.fn.string(id), vim.v.count, vim.fn.string(vim.v.register))
+		return 'g@'
+	end
+
+	-- called through 'operatorfunc'
+	function O.run(motion, id, count, register)
+		local s, e = vim.api.nvim_buf_get_mark(0, '['), vim.api.nvim_buf_get_mark(0, ']')
+		call(id, motion, s, e, count, register, false)
+	end
+
+	-- called by the <Cmd> mapping in visual mode, it leaves 'operatorfunc' and
+	-- the state of "." alone
+	function O.visual(id)
+		local mode = vim.fn.mode()
+		local s, e = vim.fn.getpos('v'), vim.fn.getpos('.')
+		local curswant = vim.fn.winsaveview().curswant
+		local count, register = vim.v.count, vim.v.register
+
+		vim.cmd('normal! ' .. vim.api.nvim_replace_termcodes('<Esc>', true, false, true))
+
+		if s[2] > e[2] or (s[2] == e[2] and s[3] > e[3]) then
+			s, e = e, s
+		end
+		local from, to = { s[2], s[3] - 1 }, { e[2], e[3] - 1 }
+
+		local motion = 'char'
+		if mode == 'V' then
+			motion = 'line'
+		elseif mode ~= 'v' then
+			motion = 'block'
+		end
+
+		call(id, motion, from, to, count, register, true, motion == 'block' and curswant == vim.v.maxcol and -1 or nil)
+	end
+
+	local id, fn, hid = ...
+	if id then
+		O.operators[id] = { fn = fn, hid = hid }
+	end
+`
+
+// SetOperator maps `keys` in normal and visual mode to an operator that calls
+// `fn` with the text of the motion or the selection. The operator can be
+// repeated with ".".
+//
+//	api.Global.KeyMaps.SetOperator("gs", func(ctx neovim.OperatorContext) {
+//	  ctx.SetText(sort(ctx.Text()))
+//	})
+func (m KeyMaps) SetOperator(keys string, fn func(OperatorContext)) disposables.Disposable {
+	d := disposables.NewCollection()
+
+	handler := m.api.Handler.Create(func(args ...interface{}) {
+		if ctx, ok := m.api.operatorContext(args); ok {
+			fn(ctx)
+		}
+	})
+	d.Add(handler)
+
+	m.api.nvim().ExecLua(operatorLua, nil, handler.uuid, handler.functionName, handler.uuid)
+	d.Add(disposables.New(func() {
+		m.api.nvim().ExecLua(`_G.GoNeovimOperator.operators[...] = nil`, nil, handler.uuid)
+	}))
+
+	opts := defaultKeyMapOptions
+	opts.Expr = true
+	if n, err := m.Map(ModeNormal, keys, fmt.Sprintf(`v:lua.GoNeovimOperator.setup('%s')`, handler.uuid), opts); err == nil {
+		d.Add(n)
+	}
+	if x, err := m.Map(Mode("x"), keys, fmt.Sprintf(`<Cmd>lua GoNeovimOperator.visual('%s')<CR>`, handler.uuid), defaultKeyMapOptions); err == nil {
+		d.Add(x)
+	}
+
+	return d
+}
+
+func (api *Api) operatorContext(args []interface{}) (OperatorContext, bool) {
+	if len(args) != 12 {
+		return OperatorContext{}, false
+	}
+
+	ints := make([]int, 4)
+	for i := range ints {
+		ints[i], _ = toInt(args[i+1])
+	}
+
+	motion, _ := args[0].(string)
+	count, _ := toInt(args[5])
+	register, _ := args[6].(string)
+	buf, _ := toInt(args[7])
+	win, _ := toInt(args[8])
+	visual, _ := args[9].(bool)
+	vleft, _ := toInt(args[10])
+	vright, _ := toInt(args[11])
+
+	return OperatorContext{
+		Range:    NewRange(ints[0], ints[1], ints[2], ints[3]),
+		Motion:   MotionType(motion),
+		Count:    count,
+		Register: register,
+		Buffer:   newBufferById(api, nvim.Buffer(buf)),
+		Window:   newWindowById(api, nvim.Window(win)),
+		Visual:   visual,
+		vcols:    [2]int{vleft, vright},
+	}, true
+}
+
+const operatorTextLua = `
+	local buf, motion, s, e, vcols, replacement, join = ...
+
+	-- runs the changes in f as one undo step, joined with the previous one
+	local function edit(f)
+		vim.api.nvim_buf_call(buf, function()
+			if join then
+				-- undojoin fails right after an undo, the change gets its own entry then
+				pcall(vim.cmd, 'undojoin')
+			end
+			f()
+		end)
+	end
+
+	if motion == 'line' then
+		if replacement then
+			edit(function()
+				vim.api.nvim_buf_set_lines(buf, s[1] - 1, e[1] - 1, true, replacement)
+			end)
+			return ''
+		end
+		return table.concat(vim.api.nvim_buf_get_lines(buf, s[1] - 1, e[1] - 1, true), '\n')
+	end
+
+	if motion == 'char' then
+		if replacement then
+			edit(function()
+				vim.api.nvim_buf_set_text(buf, s[1] - 1, s[2], e[1] - 1, e[2], replacement)
+			end)
+			return ''
+		end
+		return table.concat(vim.api.nvim_buf_get_text(buf, s[1] - 1, s[2], e[1] - 1, e[2], {}), '\n')
+	end
+
+	local lines = {}
+	for l = s[1], e[1] do
+		local text = vim.api.nvim_buf_get_lines(buf, l - 1, l, true)[1]
+		local sc, ec
+		vim.api.nvim_buf_call(buf, function()
+			sc, ec = _G.GoNeovimOperator.span(text, vcols[1], vcols[2])
+		end)
+		if replacement then
+			edit(function()
+				vim.api.nvim_buf_set_text(buf, l - 1, sc, l - 1, ec, { replacement[l - s[1] + 1] or '' })
+			end)
+			join = true
+		else
+			table.insert(lines, text:sub(sc + 1, ec))
+		end
+	end
+	return table.concat(lines, '\n')
+`
+
+// Text returns the text the operator is applied to. Lines are separated by
+// "\n", for blockwise motions these are the parts of the lines in the block.
+func (ctx OperatorContext) Text() string {
+	var text string
+	ctx.Buffer.api.nvim().ExecLua(operatorTextLua, &text, ctx.Buffer.id, ctx.Motion, ctx.Range.Start, ctx.Range.End, ctx.vcols, nil)
+	return text
+}
+
+// SetText replaces the text the operator is applied to with `text`. Inside of
+// Buffer.Undoable() the change is joined with the other changes.
+func (ctx OperatorContext) SetText(text string) {
+	b := ctx.Buffer
+	defer b.lock()()
+
+	b.api.nvim().ExecLua(operatorTextLua, nil, b.id, ctx.Motion, ctx.Range.Start, ctx.Range.End, ctx.vcols, strings.Split(text, "\n"), b.undo.join())
+}
@@ -1,6 +1,125 @@
 package neovim
 
-type BoolOption string
-type StringOption string
-type IntOption string
+import "github.com/neovim/go-client/nvim"
 
+//go:generate go run ./tools/genoptions -o options_gen.go
+
+// Option is the name of an option with values of type T. A constant for every
+// option is declared in options_gen.go, along with typed accessors on
+// OptionValues:
+//
+//	values := api.CurrentBuffer().Options.Values()
+//	tw := neovim.OptionTextwidth.Get(values) // same as values.Textwidth()
+type Option[T any] string
+
+type BoolOption = Option[bool]
+type StringOption = Option[string]
+type IntOption = Option[int]
+
+// Get returns the value of the option in `o`.
+func (name Option[T]) Get(o OptionValues) T {
+	var value T
+	o.Get(string(name), &value)
+	return value
+}
+
+// Set sets the value of the option in `o`.
+func (name Option[T]) Set(o OptionValues, value T) error {
+	return o.Set(string(name), value)
+}
+
+type OptionScope string
+
+const (
+	// like :set, local and global value
+	OptionScopeDefault OptionScope = ""
+
+	// like :setglobal
+	OptionScopeGlobal OptionScope = "global"
+
+	// like :setlocal
+	OptionScopeLocal OptionScope = "local"
+)
+
+// OptionValues reads and writes the value of any option in a scope, with
+// nvim_get_option_value() and nvim_set_option_value().
+type OptionValues struct {
+	api    *Api
+	scope  OptionScope
+	window nvim.Window
+	buffer nvim.Buffer
+}
+
+// Options returns the option values of the current window and buffer in
+// `scope`.
+func (api *Api) Options(scope OptionScope) OptionValues {
+	return OptionValues{api: api, scope: scope}
+}
+
+// Values returns the option values of the window, in local scope.
+func (o *WindowOptions) Values() OptionValues {
+	return OptionValues{api: o.api, scope: OptionScopeLocal, window: o.windowID}
+}
+
+// Values returns the option values of the buffer.
+func (o *BufferOptions) Values() OptionValues {
+	return OptionValues{api: o.api, buffer: o.bufferID}
+}
+
+// Values returns the global option values.
+func (o GlobalOptions) Values() OptionValues {
+	return OptionValues{api: o.api, scope: OptionScopeGlobal}
+}
+
+// WithScope returns the option values in `scope`.
+func (o OptionValues) WithScope(scope OptionScope) OptionValues {
+	o.scope = scope
+	return o
+}
+
+func (o OptionValues) opts() map[string]interface{} {
+	opts := map[string]interface{}{}
+	if o.scope != OptionScopeDefault {
+		opts["scope"] = string(o.scope)
+	}
+	if o.window != 0 {
+		opts["win"] = o.window
+	}
+	if o.buffer != 0 {
+		opts["buf"] = o.buffer
+	}
+	return opts
+}
+
+// Get reads the value of the option `name` into `result`.
+func (o OptionValues) Get(name string, result interface{}) error {
+	return o.api.nvim().Request("nvim_get_option_value", result, name, o.opts())
+}
+
+func (o OptionValues) Set(name string, value interface{}) error {
+	return o.api.nvim().Request("nvim_set_option_value", nil, name, value, o.opts())
+}
+
+func (o OptionValues) Bool(name BoolOption) bool {
+	return name.Get(o)
+}
+
+func (o OptionValues) SetBool(name BoolOption, value bool) {
+	name.Set(o, value)
+}
+
+func (o OptionValues) String(name StringOption) string {
+	return name.Get(o)
+}
+
+func (o OptionValues) SetString(name StringOption, value string) {
+	name.Set(o, value)
+}
+
+func (o OptionValues) Int(name IntOption) int {
+	return name.Get(o)
+}
+
+func (o OptionValues) SetInt(name IntOption, value int) {
+	name.Set(o, value)
+}
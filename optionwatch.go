@@ -0,0 +1,123 @@
+package neovim
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/josa42/go-neovim/disposables"
+)
+
+const optionWatchLua = `
+	local group, name, win, buf, fn, id = ...
+
+	vim.api.nvim_create_autocmd('OptionSet', {
+		group = vim.api.nvim_create_augroup(group, { clear = true }),
+		pattern = name,
+		callback = function()
+			local scope = vim.v.option_type
+			local cmd = vim.v.option_command or ''
+
+			if win or buf then
+				if cmd == 'setglobal' then return end
+				if win and vim.api.nvim_get_current_win() ~= win then return end
+				if buf and vim.api.nvim_get_current_buf() ~= buf then return end
+			elseif scope == 'local' then
+				return
+			end
+
+			vim.fn[fn](id, vim.v.option_old, vim.v.option_new, scope)
+		end,
+	})
+`
+
+// Watch calls `fn` with the old and new value when the option `name` is set.
+// Option values of a window or buffer only report changes of that window or
+// buffer, global option values only report changes of the global value.
+func (o OptionValues) Watch(name string, fn func(old, new interface{}, scope OptionScope)) disposables.Disposable {
+	handler := o.api.Handler.Create(func(args ...interface{}) {
+		if len(args) != 3 {
+			return
+		}
+		scope, _ := args[2].(string)
+		fn(args[0], args[1], OptionScope(scope))
+	})
+	groupName := fmt.Sprintf("option_%s", handler.uuid)
+
+	var win, buf interface{}
+	if o.window != 0 {
+		win = o.window
+	}
+	if o.buffer != 0 {
+		buf = o.buffer
+	}
+
+	o.api.nvim().ExecLua(optionWatchLua, nil, groupName, name, win, buf, handler.functionName, handler.uuid)
+
+	return disposables.New(func() {
+		handler.Dispose()
+		o.api.Executef("silent! autocmd! %s", groupName)
+	})
+}
+
+// Watch calls `fn` with the old and new value when the option is set in `o`,
+// like OptionValues.Watch().
+func (name Option[T]) Watch(o OptionValues, fn func(old, new T, scope OptionScope)) disposables.Disposable {
+	return o.Watch(string(name), func(old, new interface{}, scope OptionScope) {
+		fn(optionValue[T](old), optionValue[T](new), scope)
+	})
+}
+
+func (o OptionValues) WatchBool(name BoolOption, fn func(old, new bool, scope OptionScope)) disposables.Disposable {
+	return name.Watch(o, fn)
+}
+
+func (o OptionValues) WatchString(name StringOption, fn func(old, new string, scope OptionScope)) disposables.Disposable {
+	return name.Watch(o, fn)
+}
+
+func (o OptionValues) WatchInt(name IntOption, fn func(old, new int, scope OptionScope)) disposables.Disposable {
+	return name.Watch(o, fn)
+}
+
+// v:option_old and v:option_new are numbers or strings, depending on the
+// neovim version.
+
+// optionValue converts `v` to T, which is a bool, a number or a string type.
+func optionValue[T any](v interface{}) T {
+	var value T
+	if v == nil {
+		return value
+	}
+
+	r := reflect.ValueOf(&value).Elem()
+	switch r.Kind() {
+	case reflect.Bool:
+		r.SetBool(optionBool(v))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		r.SetInt(int64(optionInt(v)))
+	case reflect.String:
+		r.SetString(fmt.Sprint(v))
+	}
+
+	return value
+}
+
+func optionBool(v interface{}) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return optionInt(v) != 0
+}
+
+func optionInt(v interface{}) int {
+	if s, ok := v.(string); ok {
+		i, _ := strconv.Atoi(s)
+		return i
+	}
+	if b, ok := v.(bool); ok && b {
+		return 1
+	}
+	i, _ := toInt(v)
+	return i
+}
@@ -0,0 +1,27 @@
+package neovim
+
+import "testing"
+
+func TestOptionValue(t *testing.T) {
+	if v := optionValue[bool](int64(1)); v != true {
+		t.Errorf("optionValue[bool](1) = %v", v)
+	}
+	if v := optionValue[bool]("0"); v != false {
+		t.Errorf(`optionValue[bool]("0") = %v`, v)
+	}
+	if v := optionValue[int]("80"); v != 80 {
+		t.Errorf(`optionValue[int]("80") = %v`, v)
+	}
+	if v := optionValue[int](uint64(4)); v != 4 {
+		t.Errorf("optionValue[int](4) = %v", v)
+	}
+	if v := optionValue[string]("inclusive"); v != "inclusive" {
+		t.Errorf(`optionValue[string]("inclusive") = %q`, v)
+	}
+	if v := optionValue[GlobalSelectionValue]("exclusive"); v != GlobalSelectionExclusive {
+		t.Errorf(`optionValue[GlobalSelectionValue]("exclusive") = %q`, v)
+	}
+	if v := optionValue[string](nil); v != "" {
+		t.Errorf("optionValue[string](nil) = %q", v)
+	}
+}
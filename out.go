@@ -25,6 +25,17 @@ func (o *Out) Messagef(format string, args ...interface{}) {
 	o.command("echomsg", escape(fmt.Sprintf(format, args...)))
 }
 
+func (o *Out) Warn(str string) {
+	if o.api.p.Nvim == nil {
+		return
+	}
+	o.api.p.Nvim.Command(fmt.Sprintf(`echohl WarningMsg | echomsg "%s" | echohl None`, escape(str)))
+}
+
+func (o *Out) Warnf(format string, args ...interface{}) {
+	o.Warn(fmt.Sprintf(format, args...))
+}
+
 func (o *Out) Error(str string) {
 	o.command("echoerr", escape(str))
 }
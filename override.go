@@ -0,0 +1,188 @@
+package neovim
+
+import "github.com/neovim/go-client/nvim"
+
+// Override changes a piece of editor state (an option, a register or the
+// cursor) temporarily. See Api.WithOptions().
+type Override interface {
+	snapshot(b *nvim.Batch)
+	apply(b *nvim.Batch)
+	restore(b *nvim.Batch)
+}
+
+// WithOptions applies the overrides, runs `fn` and restores the previous state,
+// even if `fn` panics. The state is saved, changed and restored with one batched
+// request each; if a batch fails, the overrides are handled one by one, so that
+// one failing override does not keep the others from being restored. If the
+// state cannot be saved or changed, `fn` is not run and the error is returned.
+func (api *Api) WithOptions(overrides []Override, fn func()) error {
+	restore, err := api.override(overrides)
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	fn()
+	return nil
+}
+
+// override saves the state and applies the overrides. It returns a function
+// that restores the state that has been saved; nothing is applied if saving
+// failed.
+func (api *Api) override(overrides []Override) (func(), error) {
+	saved, err := api.executeEach(overrides, Override.snapshot)
+
+	restore := func() {
+		reversed := make([]Override, len(saved))
+		for i, o := range saved {
+			reversed[len(saved)-1-i] = o
+		}
+		api.executeEach(reversed, Override.restore)
+	}
+
+	if err != nil {
+		return restore, err
+	}
+
+	if _, err := api.executeEach(saved, Override.apply); err != nil {
+		restore()
+		return func() {}, err
+	}
+
+	return restore, nil
+}
+
+// executeEach runs `step` of all overrides in one batch. If the batch fails,
+// it runs them one by one. It returns the overrides whose step succeeded and
+// the first error.
+func (api *Api) executeEach(overrides []Override, step func(Override, *nvim.Batch)) ([]Override, error) {
+	batch := api.nvim().NewBatch()
+	for _, o := range overrides {
+		step(o, batch)
+	}
+	if err := batch.Execute(); err == nil {
+		return overrides, nil
+	}
+
+	var firstErr error
+	done := []Override{}
+	for _, o := range overrides {
+		batch := api.nvim().NewBatch()
+		step(o, batch)
+		if err := batch.Execute(); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		done = append(done, o)
+	}
+	return done, firstErr
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Options
+
+type optionOverride struct {
+	values OptionValues
+	name   string
+	value  interface{}
+	old    interface{}
+}
+
+// OptionOverride sets the option `name` to `value`, in the scope of `values`.
+func OptionOverride(values OptionValues, name string, value interface{}) Override {
+	return &optionOverride{values: values, name: name, value: value}
+}
+
+func (o *optionOverride) snapshot(b *nvim.Batch) {
+	b.Request("nvim_get_option_value", &o.old, o.name, o.values.opts())
+}
+
+func (o *optionOverride) apply(b *nvim.Batch) {
+	b.Request("nvim_set_option_value", nil, o.name, o.value, o.values.opts())
+}
+
+func (o *optionOverride) restore(b *nvim.Batch) {
+	b.Request("nvim_set_option_value", nil, o.name, o.old, o.values.opts())
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Registers
+
+type registerOverride struct {
+	name  string
+	value *RegisterValue
+	lines []string
+	typ   string
+}
+
+// RegisterOverride sets the register `name` to `value`.
+func RegisterOverride(name string, value RegisterValue) Override {
+	return &registerOverride{name: name, value: &value}
+}
+
+// KeepRegister restores the register `name`, without changing it first.
+func KeepRegister(name string) Override {
+	return &registerOverride{name: name}
+}
+
+func (o *registerOverride) snapshot(b *nvim.Batch) {
+	b.Call("getreg", &o.lines, o.name, 1, 1)
+	b.Call("getregtype", &o.typ, o.name)
+}
+
+func (o *registerOverride) apply(b *nvim.Batch) {
+	if o.value != nil {
+		setRegister(b, o.name, o.value.Lines, o.value.regtype())
+	}
+}
+
+func (o *registerOverride) restore(b *nvim.Batch) {
+	setRegister(b, o.name, o.lines, o.typ)
+}
+
+func setRegister(b *nvim.Batch, name string, lines []string, regtype string) {
+	if lines == nil {
+		lines = []string{}
+	}
+
+	var result int
+	b.Call("setreg", &result, name, map[string]interface{}{
+		"regcontents": lines,
+		"regtype":     regtype,
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Cursor
+
+type cursorOverride struct {
+	window *Window
+	value  *Cursor
+	old    [2]int
+}
+
+// CursorOverride moves the cursor of `win` to `c`.
+func CursorOverride(win *Window, c Cursor) Override {
+	return &cursorOverride{window: win, value: &c}
+}
+
+// KeepCursor restores the cursor position of `win`, without moving it first.
+func KeepCursor(win *Window) Override {
+	return &cursorOverride{window: win}
+}
+
+func (o *cursorOverride) snapshot(b *nvim.Batch) {
+	b.WindowCursor(o.window.id, &o.old)
+}
+
+func (o *cursorOverride) apply(b *nvim.Batch) {
+	if o.value != nil {
+		b.SetWindowCursor(o.window.id, *o.value)
+	}
+}
+
+func (o *cursorOverride) restore(b *nvim.Batch) {
+	b.SetWindowCursor(o.window.id, o.old)
+}
@@ -0,0 +1,160 @@
+package neovim
+
+type QuickfixItemType string
+
+const (
+	QuickfixItemDefault QuickfixItemType = ""
+	QuickfixItemError   QuickfixItemType = "E"
+	QuickfixItemWarning QuickfixItemType = "W"
+	QuickfixItemInfo    QuickfixItemType = "I"
+	QuickfixItemNote    QuickfixItemType = "N"
+)
+
+// QuickfixItem is an entry of the quickfix or a location list. Either Buffer or
+// Filename identifies the file. Lnum and Col are 1-based.
+type QuickfixItem struct {
+	Buffer   int              `msgpack:"bufnr,omitempty"`
+	Filename string           `msgpack:"filename,omitempty"`
+	Lnum     int              `msgpack:"lnum"`
+	Col      int              `msgpack:"col"`
+	EndLnum  int              `msgpack:"end_lnum,omitempty"`
+	EndCol   int              `msgpack:"end_col,omitempty"`
+	Text     string           `msgpack:"text"`
+	Type     QuickfixItemType `msgpack:"type,omitempty"`
+}
+
+// NewQuickfixItem creates an item that covers `r`. A range that ends at column
+// 0 of a line ends with the previous line, the item has no end column then.
+func NewQuickfixItem(r Range, text string) QuickfixItem {
+	item := QuickfixItem{
+		Lnum: r.Start.Y(),
+		Col:  r.Start.X() + 1,
+		Text: text,
+	}
+	if r.IsEmpty() {
+		return item
+	}
+
+	if r.End.X() == 0 && r.End.Y() > r.Start.Y() {
+		item.EndLnum = r.End.Y() - 1
+	} else {
+		item.EndLnum = r.End.Y()
+		item.EndCol = r.End.X() + 1
+	}
+
+	return item
+}
+
+// Range returns the range the item covers. Items without an end column end
+// with their last line.
+func (i QuickfixItem) Range() Range {
+	if i.EndLnum == 0 {
+		return NewRange(i.Lnum, max(i.Col-1, 0), i.Lnum, max(i.Col-1, 0))
+	}
+	if i.EndCol == 0 {
+		return NewRange(i.Lnum, max(i.Col-1, 0), i.EndLnum+1, 0)
+	}
+	return NewRange(i.Lnum, max(i.Col-1, 0), i.EndLnum, i.EndCol-1)
+}
+
+type QuickfixAction string
+
+const (
+	// create a new list after the current one
+	QuickfixActionNew QuickfixAction = " "
+
+	// add the items to the current list
+	QuickfixActionAppend QuickfixAction = "a"
+
+	// replace the items of the current list
+	QuickfixActionReplace QuickfixAction = "r"
+
+	// free all lists
+	QuickfixActionFree QuickfixAction = "f"
+)
+
+type quickfixList struct {
+	Items []QuickfixItem `msgpack:"items"`
+	Title string         `msgpack:"title"`
+}
+
+func quickfixWhat(items []QuickfixItem, title string) map[string]interface{} {
+	if items == nil {
+		items = []QuickfixItem{}
+	}
+
+	what := map[string]interface{}{"items": items}
+	if title != "" {
+		what["title"] = title
+	}
+	return what
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Quickfix
+
+func (api *Api) SetQuickfix(items []QuickfixItem, action QuickfixAction, title string) {
+	var result int
+	api.nvim().Call("setqflist", &result, []interface{}{}, string(action), quickfixWhat(items, title))
+}
+
+func (api *Api) Quickfix() []QuickfixItem {
+	var list quickfixList
+	api.nvim().Call("getqflist", &list, map[string]int{"items": 1})
+	return list.Items
+}
+
+func (api *Api) QuickfixTitle() string {
+	var list quickfixList
+	api.nvim().Call("getqflist", &list, map[string]int{"title": 1})
+	return list.Title
+}
+
+// OpenQuickfix opens the quickfix window. A `height` of 0 uses the default.
+func (api *Api) OpenQuickfix(height int) {
+	if height > 0 {
+		api.Executef("copen %d", height)
+	} else {
+		api.Execute("copen")
+	}
+}
+
+func (api *Api) CloseQuickfix() {
+	api.Execute("cclose")
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Location List
+
+func (w *Window) SetLocList(items []QuickfixItem, action QuickfixAction, title string) {
+	var result int
+	w.api.nvim().Call("setloclist", &result, w.ID(), []interface{}{}, string(action), quickfixWhat(items, title))
+}
+
+func (w *Window) LocList() []QuickfixItem {
+	var list quickfixList
+	w.api.nvim().Call("getloclist", &list, w.ID(), map[string]int{"items": 1})
+	return list.Items
+}
+
+// OpenLocList opens the location list window of `w` and moves the cursor to
+// it. A `height` of 0 uses the default.
+func (w *Window) OpenLocList(height int) {
+	w.Focus()
+	if height > 0 {
+		w.api.Executef("lopen %d", height)
+	} else {
+		w.api.Execute("lopen")
+	}
+}
+
+func (w *Window) CloseLocList() {
+	w.call("lclose")
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,28 @@
+package neovim
+
+import "testing"
+
+func TestQuickfixItemRange(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		item QuickfixItem
+	}{
+		{"position", NewRange(3, 4, 3, 4), QuickfixItem{Lnum: 3, Col: 5}},
+		{"characters", NewRange(3, 4, 3, 9), QuickfixItem{Lnum: 3, Col: 5, EndLnum: 3, EndCol: 10}},
+		{"multi line", NewRange(3, 4, 5, 2), QuickfixItem{Lnum: 3, Col: 5, EndLnum: 5, EndCol: 3}},
+		{"line", LineRange(3, 3), QuickfixItem{Lnum: 3, Col: 1, EndLnum: 3}},
+		{"lines", LineRange(3, 5), QuickfixItem{Lnum: 3, Col: 1, EndLnum: 5}},
+		{"to end of line", NewRange(3, 4, 5, 0), QuickfixItem{Lnum: 3, Col: 5, EndLnum: 4}},
+	}
+
+	for _, tt := range tests {
+		item := NewQuickfixItem(tt.r, "")
+		if item != tt.item {
+			t.Errorf("%s: NewQuickfixItem(%v) = %+v, want %+v", tt.name, tt.r, item, tt.item)
+		}
+		if r := item.Range(); r != tt.r {
+			t.Errorf("%s: Range() = %v, want %v", tt.name, r, tt.r)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package neovim
+
+// Range spans from Start to End. Like Cursor, lines are 1-based and columns are
+// 0-based byte offsets. End is exclusive, like the end of a diagnostic or of
+// nvim_buf_get_text(): it is the position right after the last character. A
+// range of whole lines ends at column 0 of the following line.
+type Range struct {
+	Start Cursor
+	End   Cursor
+}
+
+func NewRange(startLine, startCol, endLine, endCol int) Range {
+	return Range{
+		Start: Cursor{startLine, startCol},
+		End:   Cursor{endLine, endCol},
+	}
+}
+
+// LineRange spans the lines `start` to `end` (both 1-based and inclusive).
+func LineRange(start, end int) Range {
+	return NewRange(start, 0, end+1, 0)
+}
+
+func (r Range) IsEmpty() bool {
+	return r.Start == r.End
+}
+
+// Contains reports whether `c` is located inside the range.
+func (r Range) Contains(c Cursor) bool {
+	if c.Y() < r.Start.Y() || c.Y() > r.End.Y() {
+		return false
+	}
+	if c.Y() == r.Start.Y() && c.X() < r.Start.X() {
+		return false
+	}
+	if c.Y() == r.End.Y() && c.X() >= r.End.X() {
+		return false
+	}
+	return true
+}
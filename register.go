@@ -0,0 +1,96 @@
+package neovim
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	RegisterUnnamed   = `"`
+	RegisterClipboard = "+"
+	RegisterSelection = "*"
+	RegisterYank      = "0"
+	RegisterBlackHole = "_"
+)
+
+type RegisterType int
+
+const (
+	RegisterCharwise RegisterType = iota
+	RegisterLinewise
+	RegisterBlockwise
+)
+
+type RegisterValue struct {
+	Lines []string
+	Type  RegisterType
+
+	// Width of a blockwise register.
+	Width int
+}
+
+// parseRegisterType parses the result of getregtype().
+func parseRegisterType(typ string) (RegisterType, int) {
+	switch {
+	case typ == "V":
+		return RegisterLinewise, 0
+	case len(typ) > 0 && typ[0] == 0x16:
+		width, _ := strconv.Atoi(typ[1:])
+		return RegisterBlockwise, width
+	default:
+		return RegisterCharwise, 0
+	}
+}
+
+func (r RegisterValue) regtype() string {
+	switch r.Type {
+	case RegisterLinewise:
+		return "V"
+	case RegisterBlockwise:
+		if r.Width > 0 {
+			return fmt.Sprintf("\x16%d", r.Width)
+		}
+		return "\x16"
+	default:
+		return "v"
+	}
+}
+
+func (api *Api) Register(name string) RegisterValue {
+	var lines []string
+	var typ string
+
+	batch := api.nvim().NewBatch()
+	batch.Call("getreg", &lines, name, 1, 1)
+	batch.Call("getregtype", &typ, name)
+	batch.Execute()
+
+	if lines == nil {
+		lines = []string{}
+	}
+
+	reg := RegisterValue{Lines: lines}
+	reg.Type, reg.Width = parseRegisterType(typ)
+
+	return reg
+}
+
+func (api *Api) SetRegister(name string, reg RegisterValue) {
+	batch := api.nvim().NewBatch()
+	setRegister(batch, name, reg.Lines, reg.regtype())
+	batch.Execute()
+}
+
+// SaveRegisters backups the given registers and returns a function that
+// restores them:
+//
+//	defer api.SaveRegisters(RegisterUnnamed)()
+func (api *Api) SaveRegisters(names ...string) func() {
+	overrides := []Override{}
+	for _, name := range names {
+		overrides = append(overrides, KeepRegister(name))
+	}
+
+	restore, _ := api.override(overrides)
+	return restore
+}
@@ -0,0 +1,24 @@
+package neovim
+
+import "testing"
+
+func TestParseRegisterType(t *testing.T) {
+	tests := []struct {
+		typ   string
+		want  RegisterType
+		width int
+	}{
+		{"v", RegisterCharwise, 0},
+		{"", RegisterCharwise, 0},
+		{"V", RegisterLinewise, 0},
+		{"\x165", RegisterBlockwise, 5},
+		{"\x16", RegisterBlockwise, 0},
+	}
+
+	for _, tt := range tests {
+		typ, width := parseRegisterType(tt.typ)
+		if typ != tt.want || width != tt.width {
+			t.Errorf("parseRegisterType(%q) = %v, %d, want %v, %d", tt.typ, typ, width, tt.want, tt.width)
+		}
+	}
+}
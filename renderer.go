@@ -46,10 +46,13 @@ func (r *ViewRenderer) render() {
 		v.Update()
 	}
 
-	defer makeWritable(r.buffer)()
-	defer restoreCurorPosition(r.buffer.api.CurrentWindow())()
+	for _, win := range r.buffer.Windows() {
+		defer restoreView(win)()
+	}
 
-	r.buffer.SetLines(r.view.Lines())
+	r.buffer.WithoutUndo(func() {
+		r.buffer.SetLines(r.view.Lines())
+	})
 	r.buffer.Freeze()
 }
 
@@ -97,32 +100,10 @@ func (r *Renderer) Attach(b *Buffer, view View) {
 	vr.ShouldRender()
 }
 
-func makeWritable(b *Buffer) func() {
-	readonly := b.Options.ReadOnly()
-	modifiable := b.Options.Modifiable()
-
-	if readonly {
-		b.Options.SetReadOnly(false)
-	}
-
-	if !modifiable {
-		b.Options.SetModifiable(true)
-	}
-
-	return func() {
-		if readonly {
-			b.Options.SetReadOnly(readonly)
-		}
-		if !modifiable {
-			b.Options.SetModifiable(modifiable)
-		}
-	}
-}
-
-func restoreCurorPosition(win *Window) func() {
-	cur := win.Cursor()
+func restoreView(win *Window) func() {
+	view := win.SaveView()
 
 	return func() {
-		win.SetCursor(Cursor{cur[0], 0})
+		win.RestoreView(view)
 	}
 }
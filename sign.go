@@ -0,0 +1,125 @@
+package neovim
+
+import (
+	"fmt"
+
+	"github.com/josa42/go-neovim/disposables"
+)
+
+type Sign struct {
+	ID       int    `msgpack:"id"`
+	Name     string `msgpack:"name"`
+	Group    string `msgpack:"group"`
+	Line     int    `msgpack:"lnum"`
+	Priority int    `msgpack:"priority"`
+}
+
+type placedSigns struct {
+	Buffer int    `msgpack:"bufnr"`
+	Signs  []Sign `msgpack:"signs"`
+}
+
+// DefineSign defines (or redefines) the sign `name`. Empty values for
+// `texthl` and `numhl` are left unset.
+func (api *Api) DefineSign(name, text, texthl, numhl string) {
+	def := map[string]string{"text": text}
+	if texthl != "" {
+		def["texthl"] = texthl
+	}
+	if numhl != "" {
+		def["numhl"] = numhl
+	}
+
+	var result int
+	api.nvim().Call("sign_define", &result, name, def)
+}
+
+func (api *Api) UndefineSign(name string) {
+	var result int
+	api.nvim().Call("sign_undefine", &result, name)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Buffer
+
+// PlaceSign places the sign `name` on `line` (1-based) and returns its id.
+// Signs are attached to the line and move with it when the buffer is edited.
+func (b *Buffer) PlaceSign(group, name string, line, priority int) int {
+	opts := map[string]int{"lnum": line}
+	if priority > 0 {
+		opts["priority"] = priority
+	}
+
+	var id int
+	b.api.nvim().Call("sign_place", &id, 0, group, name, b.ID(), opts)
+	return id
+}
+
+func (b *Buffer) UnplaceSign(group string, id int) {
+	var result int
+	b.api.nvim().Call("sign_unplace", &result, group, map[string]int{"buffer": b.ID(), "id": id})
+}
+
+// UnplaceSigns removes all signs of `group` from the buffer.
+func (b *Buffer) UnplaceSigns(group string) {
+	var result int
+	b.api.nvim().Call("sign_unplace", &result, group, map[string]int{"buffer": b.ID()})
+}
+
+// Signs returns the signs of `group` placed in the buffer, sorted by line. Use
+// "*" to list signs of all groups.
+func (b *Buffer) Signs(group string) []Sign {
+	var placed []placedSigns
+	b.api.nvim().Call("sign_getplaced", &placed, b.ID(), map[string]string{"group": group})
+
+	signs := []Sign{}
+	for _, p := range placed {
+		signs = append(signs, p.Signs...)
+	}
+	return signs
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Groups
+
+var _ disposables.Disposable = (*SignGroup)(nil)
+
+// SignGroup bundles signs that are placed by one feature, so that they can be
+// removed from all buffers at once.
+type SignGroup struct {
+	api  *Api
+	name string
+}
+
+func (api *Api) NewSignGroup(name string) *SignGroup {
+	if name == "" {
+		name = fmt.Sprintf("signs_%s", generateUUID())
+	}
+	return &SignGroup{api: api, name: name}
+}
+
+func (g *SignGroup) Name() string {
+	return g.name
+}
+
+func (g *SignGroup) Place(b *Buffer, name string, line, priority int) int {
+	return b.PlaceSign(g.name, name, line, priority)
+}
+
+func (g *SignGroup) Unplace(b *Buffer, id int) {
+	b.UnplaceSign(g.name, id)
+}
+
+func (g *SignGroup) Clear(b *Buffer) {
+	b.UnplaceSigns(g.name)
+}
+
+func (g *SignGroup) Signs(b *Buffer) []Sign {
+	return b.Signs(g.name)
+}
+
+// Dispose removes the signs of the group from all buffers.
+func (g *SignGroup) Dispose() {
+	var result int
+	g.api.nvim().Call("sign_unplace", &result, g.name)
+}
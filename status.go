@@ -0,0 +1,337 @@
+package neovim
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/josa42/go-neovim/disposables"
+	"github.com/neovim/go-client/nvim"
+)
+
+// StatusSegment is a part of a statusline, winbar or tabline.
+type StatusSegment struct {
+	Text      string
+	Highlight string
+
+	// Raw segments are not escaped, to allow statusline items like "%=".
+	Raw bool
+
+	OnClick func(StatusClick)
+}
+
+type StatusClick struct {
+	// number of clicks, 1 to 4
+	Clicks int
+	// "l", "r" or "m"
+	Button string
+	// "s", "c", "a" and "m" for pressed modifier keys
+	Modifiers string
+}
+
+// StatusProvider returns the segments of a statusline, winbar or tabline for
+// `win`.
+type StatusProvider func(win *Window) []StatusSegment
+
+// Events that invalidate the cached results of a StatusProvider by default.
+var StatusEvents = []string{
+	EventBufEnter, EventWinEnter, EventBufWritePost, EventFileType,
+	EventInsertEnter, EventInsertLeave, EventDirChanged, EventVimResized,
+}
+
+type statusKind int
+
+const (
+	statusLine statusKind = iota
+	statusWinBar
+	statusTabLine
+)
+
+const statusLua = `
+	_G.GoNeovimStatus = _G.GoNeovimStatus or { cache = {}, missing = {}, pending = {}, refresh = {} }
+	local S = _G.GoNeovimStatus
+
+	function S.invalidate(id)
+		if S.pending[id] then
+			return
+		end
+		S.pending[id] = true
+		vim.schedule(function()
+			S.pending[id] = nil
+			if S.refresh[id] then
+				S.refresh[id]()
+			end
+		end)
+	end
+
+	function _G.GoNeovimStatusGet(id)
+		local win = vim.g.statusline_winid
+		if not win or win == 0 then
+			win = vim.api.nvim_get_current_win()
+		end
+
+		local value = (S.cache[id] or {})[win]
+		if value ~= nil then
+			return value
+		end
+
+		-- render windows only once, the provider does not render all windows
+		-- that show the status, e.g. windows split from a window with a local
+		-- statusline
+		S.missing[id] = S.missing[id] or {}
+		if not S.missing[id][win] then
+			S.missing[id][win] = true
+			S.invalidate(id)
+		end
+		return ''
+	end
+
+	-- windows whose local value of option contains the status id
+	function S.windows(id, option)
+		local wins = {}
+		for _, win in ipairs(vim.api.nvim_list_wins()) do
+			local value = vim.api.nvim_get_option_value(option, { scope = 'local', win = win })
+			if value:find(id, 1, true) then
+				table.insert(wins, win)
+			end
+		end
+		return wins
+	end
+
+	local id, events, fn, hid = ...
+	S.refresh[id] = function() vim.fn[fn](hid) end
+
+	vim.api.nvim_create_autocmd(events, {
+		group = vim.api.nvim_create_augroup('go_neovim_status_' .. id, { clear = true }),
+		callback = function() S.invalidate(id) end,
+	})
+`
+
+var _ disposables.Disposable = (*Status)(nil)
+
+// Status is a statusline, winbar or tabline rendered by a StatusProvider. The
+// results are cached per window and rendered again when one of the events is
+// triggered or Invalidate() is called.
+type Status struct {
+	api      *Api
+	id       string
+	kind     statusKind
+	window   *Window
+	provider StatusProvider
+
+	mutex     *sync.Mutex
+	clicks    map[int]func(StatusClick)
+	nextClick int
+	clickFunc string
+
+	// click ids of the cached value of each window
+	windowClicks map[int][]int
+
+	disposables *disposables.Collection
+}
+
+// SetStatusLine renders the statusline of `win` with `provider`. If `win` is
+// nil, the global statusline is set.
+func (api *Api) SetStatusLine(win *Window, provider StatusProvider, events ...string) *Status {
+	return api.setStatus(statusLine, win, provider, events)
+}
+
+// SetWinBar renders the winbar of `win` with `provider`. If `win` is nil, the
+// global winbar is set.
+func (api *Api) SetWinBar(win *Window, provider StatusProvider, events ...string) *Status {
+	return api.setStatus(statusWinBar, win, provider, events)
+}
+
+// SetTabLine renders the tabline with `provider`. It is called with the current
+// window.
+func (api *Api) SetTabLine(provider StatusProvider, events ...string) *Status {
+	return api.setStatus(statusTabLine, nil, provider, events)
+}
+
+func (api *Api) setStatus(kind statusKind, win *Window, provider StatusProvider, events []string) *Status {
+	if len(events) == 0 {
+		events = StatusEvents
+	}
+
+	s := &Status{
+		api:          api,
+		id:           generateUUID(),
+		kind:         kind,
+		window:       win,
+		provider:     provider,
+		mutex:        &sync.Mutex{},
+		clicks:       map[int]func(StatusClick){},
+		windowClicks: map[int][]int{},
+		disposables:  disposables.NewCollection(),
+	}
+
+	refresh := api.Handler.Create(s.Invalidate)
+	s.disposables.Add(refresh)
+
+	click := api.Handler.Create(s.click)
+	s.disposables.Add(click)
+
+	s.clickFunc = fmt.Sprintf("GoNeovimStatusClick_%s", s.id)
+	api.nvim().Exec(fmt.Sprintf(
+		"function! %s(minwid, clicks, button, mods)\n call %s\nendfunction",
+		s.clickFunc, click.StringWithEvals("a:minwid", "a:clicks", "a:button", "a:mods"),
+	), false)
+
+	api.nvim().ExecLua(statusLua, nil, s.id, events, refresh.functionName, refresh.uuid)
+
+	previous := s.option()
+	s.setOption(fmt.Sprintf("%%!v:lua.GoNeovimStatusGet('%s')", s.id))
+
+	s.disposables.Add(disposables.New(func() {
+		s.setOption(previous)
+		api.nvim().ExecLua(`
+			local id = ...
+			local S = _G.GoNeovimStatus
+			S.cache[id], S.missing[id], S.refresh[id], S.pending[id] = nil, nil, nil, nil
+			pcall(vim.api.nvim_del_augroup_by_name, 'go_neovim_status_' .. id)
+		`, nil, s.id)
+		api.Executef("delfunction! %s", s.clickFunc)
+	}))
+
+	s.Invalidate()
+
+	return s
+}
+
+func (s *Status) option() string {
+	switch {
+	case s.kind == statusTabLine:
+		return s.api.Global.Options.TabLine()
+	case s.kind == statusWinBar && s.window != nil:
+		return s.window.Options.WinBar()
+	case s.kind == statusWinBar:
+		return s.api.Global.Options.WinBar()
+	case s.window != nil:
+		return s.window.Options.StatusLine()
+	default:
+		return s.api.Global.Options.StatusLine()
+	}
+}
+
+func (s *Status) setOption(value string) {
+	switch {
+	case s.kind == statusTabLine:
+		s.api.Global.Options.SetTabLine(value)
+	case s.kind == statusWinBar && s.window != nil:
+		s.window.Options.SetWinBar(value)
+	case s.kind == statusWinBar:
+		s.api.Global.Options.SetWinBar(value)
+	case s.window != nil:
+		s.window.Options.SetStatusLine(value)
+	default:
+		s.api.Global.Options.SetStatusLine(value)
+	}
+}
+
+// Invalidate renders the status again for all windows it is shown in.
+func (s *Status) Invalidate() {
+	windows := []*Window{}
+	switch {
+	case s.kind == statusTabLine:
+		windows = append(windows, s.api.CurrentWindow())
+	case s.window != nil:
+		// the local value is copied to windows split from s.window
+		option := "statusline"
+		if s.kind == statusWinBar {
+			option = "winbar"
+		}
+		var ids []int
+		s.api.nvim().ExecLua(`return _G.GoNeovimStatus.windows(...)`, &ids, s.id, option)
+		for _, id := range ids {
+			windows = append(windows, newWindowById(s.api, nvim.Window(id)))
+		}
+	default:
+		windows = s.api.CurrentTab().Windows()
+	}
+
+	cache := map[int]string{}
+	for _, win := range windows {
+		cache[win.ID()] = s.format(win.ID(), s.provider(win))
+	}
+
+	redraw := "redrawstatus!"
+	if s.kind == statusTabLine {
+		redraw = "redrawtabline"
+	}
+
+	s.api.nvim().ExecLua(`
+		local id, values, redraw = ...
+		local S = _G.GoNeovimStatus
+		S.cache[id] = S.cache[id] or {}
+		S.missing[id] = S.missing[id] or {}
+		for win, value in pairs(values) do
+			S.cache[id][win] = value
+			S.missing[id][win] = nil
+		end
+		vim.cmd(redraw)
+	`, nil, s.id, cache, redraw)
+}
+
+// format renders the segments for the window `win`, replacing the click
+// handlers of its previous value.
+func (s *Status) format(win int, segments []StatusSegment) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, id := range s.windowClicks[win] {
+		delete(s.clicks, id)
+	}
+	s.windowClicks[win] = nil
+
+	b := strings.Builder{}
+	for _, seg := range segments {
+		if seg.OnClick != nil {
+			s.nextClick++
+			s.clicks[s.nextClick] = seg.OnClick
+			s.windowClicks[win] = append(s.windowClicks[win], s.nextClick)
+			fmt.Fprintf(&b, "%%%d@%s@", s.nextClick, s.clickFunc)
+		}
+		if seg.Highlight != "" {
+			fmt.Fprintf(&b, "%%#%s#", seg.Highlight)
+		}
+
+		if seg.Raw {
+			b.WriteString(seg.Text)
+		} else {
+			b.WriteString(strings.ReplaceAll(seg.Text, "%", "%%"))
+		}
+
+		if seg.Highlight != "" {
+			b.WriteString("%*")
+		}
+		if seg.OnClick != nil {
+			b.WriteString("%X")
+		}
+	}
+
+	return b.String()
+}
+
+func (s *Status) click(args ...interface{}) {
+	if len(args) != 4 {
+		return
+	}
+
+	id, _ := toInt(args[0])
+	clicks, _ := toInt(args[1])
+	button, _ := args[2].(string)
+	mods, _ := args[3].(string)
+
+	s.mutex.Lock()
+	fn, ok := s.clicks[id]
+	s.mutex.Unlock()
+
+	if ok {
+		fn(StatusClick{Clicks: clicks, Button: button, Modifiers: strings.TrimSpace(mods)})
+	}
+}
+
+// Dispose restores the previous option value and removes the event handlers.
+func (s *Status) Dispose() {
+	s.disposables.Dispose()
+}
@@ -1,6 +1,11 @@
 package neovim
 
-import "github.com/neovim/go-client/nvim"
+import (
+	"fmt"
+
+	"github.com/josa42/go-neovim/disposables"
+	"github.com/neovim/go-client/nvim"
+)
 
 type Tab struct {
 	api  *Api
@@ -84,13 +89,64 @@ func (t *Tab) FindWindow(fn func(window *Window) bool) (*Window, bool) {
 	return newWindowById(t.api, 0), false
 }
 
+func (t *Tab) CurrentWindow() *Window {
+	win, _ := t.api.nvim().TabpageWindow(t.id)
+	return newWindowById(t.api, win)
+}
+
+// Number returns the (1-based) position of the tab.
+func (t *Tab) Number() int {
+	number, _ := t.api.nvim().TabpageNumber(t.id)
+	return number
+}
+
+func (t *Tab) Focus() {
+	t.api.nvim().SetCurrentTabpage(t.id)
+}
+
+// Move moves the tab to the (1-based) position `number`.
+func (t *Tab) Move(number int) {
+	t.api.nvim().ExecLua(`
+		local tab, number = ...
+		local current = vim.api.nvim_get_current_tabpage()
+		local from = vim.api.nvim_tabpage_get_number(tab)
+
+		vim.api.nvim_set_current_tabpage(tab)
+		vim.cmd('tabmove ' .. (number <= from and number - 1 or number))
+		if vim.api.nvim_tabpage_is_valid(current) then
+			vim.api.nvim_set_current_tabpage(current)
+		end
+	`, nil, t.id, number)
+}
+
+// Cwd returns the working directory of the tab.
+func (t *Tab) Cwd() string {
+	var cwd string
+	t.api.nvim().Call("getcwd", &cwd, -1, t.Number())
+	return cwd
+}
+
+// SetCwd sets the working directory of the tab, like :tcd.
+func (t *Tab) SetCwd(path string) error {
+	return t.api.nvim().ExecLua(`
+		local win, path = ...
+		vim.api.nvim_win_call(win, function()
+			vim.cmd('tcd ' .. vim.fn.fnameescape(path))
+		end)
+	`, nil, t.CurrentWindow().id, path)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // LIfe Cycle
 
-func (t *Tab) Close(force bool) {
-	for _, win := range t.Windows() {
-		win.Close(force)
+// Close closes the tab with :tabclose. Closing the last tab fails.
+func (t *Tab) Close(force bool) error {
+	cmd := "tabclose"
+	if force {
+		cmd = "tabclose!"
 	}
+	_, err := t.api.Executef("%s %d", cmd, t.Number())
+	return err
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -116,3 +172,70 @@ func (api *Api) FindTab(fn func(tab *Tab) bool) (*Tab, bool) {
 
 	return newTabById(api, 0), false
 }
+
+// NewTab opens `b` in a new tab and focuses it. A nil buffer opens an empty
+// buffer.
+func (api *Api) NewTab(b *Buffer) *Tab {
+	var bufnr interface{}
+	if b != nil {
+		bufnr = b.id
+	}
+
+	var id int
+	api.nvim().ExecLua(`
+		local buf = ...
+		if buf then
+			vim.cmd('tab split')
+			vim.api.nvim_win_set_buf(0, buf)
+		else
+			vim.cmd('tabnew')
+		end
+		return vim.api.nvim_get_current_tabpage()
+	`, &id, bufnr)
+
+	return newTabById(api, nvim.Tabpage(id))
+}
+
+const tabAutocmdLua = `
+	local group, event, fn, id = ...
+	local known = vim.api.nvim_list_tabpages()
+
+	vim.api.nvim_create_autocmd({ 'TabNew', 'TabClosed' }, {
+		group = vim.api.nvim_create_augroup(group, { clear = true }),
+		callback = function(args)
+			local tabs = vim.api.nvim_list_tabpages()
+
+			if args.event == event and event == 'TabNew' then
+				vim.fn[fn](id, vim.api.nvim_get_current_tabpage())
+			elseif args.event == event then
+				for _, tab in ipairs(known) do
+					if not vim.tbl_contains(tabs, tab) then
+						vim.fn[fn](id, tab)
+					end
+				end
+			end
+
+			known = tabs
+		end,
+	})
+`
+
+// OnTab calls `fn` with the affected tab when `event` (EventTabNew or
+// EventTabClosed) is triggered. Closed tabs no longer exist when `fn` is called.
+func (api *Api) OnTab(event string, fn func(tab *Tab)) disposables.Disposable {
+	handler := api.Handler.Create(func(args ...interface{}) {
+		if len(args) > 0 {
+			if id, ok := toInt(args[0]); ok {
+				fn(newTabById(api, nvim.Tabpage(id)))
+			}
+		}
+	})
+	groupName := fmt.Sprintf("tab_%s", handler.uuid)
+
+	api.nvim().ExecLua(tabAutocmdLua, nil, groupName, event, handler.functionName, handler.uuid)
+
+	return disposables.New(func() {
+		handler.Dispose()
+		api.Executef("silent! autocmd! %s", groupName)
+	})
+}
@@ -0,0 +1,152 @@
+package neovim
+
+import (
+	"fmt"
+
+	"github.com/josa42/go-neovim/disposables"
+	"github.com/neovim/go-client/nvim"
+)
+
+// TextObjectContext describes where a text object is selected.
+type TextObjectContext struct {
+	// Inner is set for the "i" variant and unset for the "a" variant.
+	Inner bool
+
+	// Count is 0 if no count has been typed.
+	Count int
+
+	Cursor Cursor
+	Buffer *Buffer
+	Window *Window
+
+	// Visual is set in visual mode, Selection is the current selection then.
+	Visual    bool
+	Selection Range
+}
+
+const textObjectLua = `
+	_G.GoNeovimTextObject = _G.GoNeovimTextObject or { objects = {} }
+	local T = _G.GoNeovimTextObject
+
+	local function visual()
+		local mode = vim.fn.mode()
+		return mode == 'v' or mode == 'V' or mode == '\22'
+	end
+
+	-- called by the mappings in operator-pending and visual mode
+	function T.select(id, inner)
+		local obj = T.objects[id]
+		if not obj then
+			return
+		end
+
+		local cursor = vim.api.nvim_win_get_cursor(0)
+		local s, e = cursor, cursor
+		if visual() then
+			local v, c = vim.fn.getpos('v'), vim.fn.getpos('.')
+			s, e = { v[2], v[3] - 1 }, { c[2], c[3] - 1 }
+			if s[1] > e[1] or (s[1] == e[1] and s[2] > e[2]) then
+				s, e = e, s
+			end
+		end
+
+		local text = vim.api.nvim_buf_get_lines(0, e[1] - 1, e[1], true)[1]
+		if e[2] < #text then
+			e = { e[1], e[2] + #vim.fn.strpart(text, e[2], 1, true) }
+		end
+
+		vim.fn[obj.fn](obj.hid, inner, vim.v.count, cursor[1], cursor[2],
+			vim.api.nvim_get_current_buf(), vim.api.nvim_get_current_win(), visual(), s[1], s[2], e[1], e[2])
+	end
+
+	-- selects the range computed by the text object
+	function T.apply(s, e)
+		if visual() then
+			vim.cmd('normal! ' .. vim.api.nvim_replace_termcodes('<Esc>', true, false, true))
+		end
+
+		-- the range ends before e, the selection includes the last character
+		if e[2] == 0 and e[1] > s[1] then
+			local line = vim.api.nvim_buf_get_lines(0, e[1] - 2, e[1] - 1, true)[1]
+			e = { e[1] - 1, math.max(#line - 1, 0) }
+		else
+			e = { e[1], e[2] - 1 }
+		end
+
+		vim.api.nvim_win_set_cursor(0, s)
+		vim.cmd('normal! v')
+		vim.api.nvim_win_set_cursor(0, e)
+	end
+
+	local id, fn, hid = ...
+	if id then
+		T.objects[id] = { fn = fn, hid = hid }
+	end
+`
+
+// SetTextObject maps the text object "a<keys>" and "i<keys>" in
+// operator-pending and visual mode. `fn` returns the range of the object, which
+// is selected characterwise; a range that ends at column 0 of a line selects up
+// to the end of the previous line. If `fn` returns false or an empty range,
+// nothing is selected.
+//
+//	api.Global.KeyMaps.SetTextObject("f", func(ctx neovim.TextObjectContext) (neovim.Range, bool) {
+//	  return functionAt(ctx.Buffer, ctx.Cursor, ctx.Inner)
+//	})
+func (m KeyMaps) SetTextObject(keys string, fn func(TextObjectContext) (Range, bool)) disposables.Disposable {
+	d := disposables.NewCollection()
+
+	handler := m.api.Handler.Create(func(args ...interface{}) {
+		ctx, ok := m.api.textObjectContext(args)
+		if !ok {
+			return
+		}
+		if r, ok := fn(ctx); ok && !r.IsEmpty() {
+			m.api.nvim().ExecLua(`_G.GoNeovimTextObject.apply(...)`, nil, r.Start, r.End)
+		}
+	})
+	d.Add(handler)
+
+	m.api.nvim().ExecLua(textObjectLua, nil, handler.uuid, handler.functionName, handler.uuid)
+	d.Add(disposables.New(func() {
+		m.api.nvim().ExecLua(`_G.GoNeovimTextObject.objects[...] = nil`, nil, handler.uuid)
+	}))
+
+	for _, variant := range []struct {
+		prefix string
+		inner  bool
+	}{{"a", false}, {"i", true}} {
+		rhs := fmt.Sprintf(`<Cmd>lua GoNeovimTextObject.select('%s', %t)<CR>`, handler.uuid, variant.inner)
+		for _, mode := range []Mode{Mode("o"), Mode("x")} {
+			if km, err := m.Map(mode, variant.prefix+keys, rhs, defaultKeyMapOptions); err == nil {
+				d.Add(km)
+			}
+		}
+	}
+
+	return d
+}
+
+func (api *Api) textObjectContext(args []interface{}) (TextObjectContext, bool) {
+	if len(args) != 11 {
+		return TextObjectContext{}, false
+	}
+
+	ints := make([]int, 11)
+	for i := range ints {
+		ints[i], _ = toInt(args[i])
+	}
+
+	inner, _ := args[0].(bool)
+	visual, _ := args[6].(bool)
+
+	return TextObjectContext{
+		Inner:     inner,
+		Count:     ints[1],
+		Cursor:    Cursor{ints[2], ints[3]},
+		Buffer:    newBufferById(api, nvim.Buffer(ints[4])),
+		Window:    newWindowById(api, nvim.Window(ints[5])),
+		Visual:    visual,
+		Selection: NewRange(ints[7], ints[8], ints[9], ints[10]),
+	}, true
+}
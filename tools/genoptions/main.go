@@ -0,0 +1,196 @@
+// genoptions generates an Option[T] constant and typed accessors on
+// neovim.OptionValues for every option returned by nvim_get_all_options_info().
+// It requires nvim in $PATH:
+//
+//	go generate github.com/josa42/go-neovim
+//
+// With -i the options are read from a JSON file instead, e.g. written with:
+//
+//	nvim --clean --headless +'lua io.write(vim.json.encode(vim.api.nvim_get_all_options_info()))' +q
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/neovim/go-client/nvim"
+)
+
+// enums maps options to the value types declared in the neovim package.
+var enums = map[string]string{
+	"bufhidden":  "BufferHiddenValue",
+	"buftype":    "BufferTypeValue",
+	"clipboard":  "GlobalClipboardValue",
+	"foldmethod": "WindowFoldMethodValue",
+	"selection":  "GlobalSelectionValue",
+	"signcolumn": "WindowSignColumnValue",
+}
+
+// types maps the types of nvim_get_all_options_info() to Go types.
+var types = map[string]string{
+	"boolean": "bool",
+	"number":  "int",
+	"string":  "string",
+}
+
+func main() {
+	out := flag.String("o", "options_gen.go", "output file")
+	in := flag.String("i", "", "JSON file with the result of nvim_get_all_options_info()")
+	flag.Parse()
+
+	infos, err := optionInfos(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	declared, err := declaredNames(filepath.Dir(*out), filepath.Base(*out))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := []string{}
+	for name := range infos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, n := range []string{constName(name), "OptionValues." + methodName(name), "OptionValues.Set" + methodName(name)} {
+			if declared[n] {
+				log.Fatalf("option %s: %s is already declared", name, n)
+			}
+		}
+	}
+
+	b := bytes.Buffer{}
+	b.WriteString("// Code generated by tools/genoptions; DO NOT EDIT.\n\n")
+	b.WriteString("package neovim\n\n")
+
+	b.WriteString("const (\n")
+	for _, name := range names {
+		info := infos[name]
+		if typ, ok := optionType(name, info); ok {
+			fmt.Fprintf(&b, "\t%s Option[%s] = %q // %s\n", constName(name), typ, name, info.Scope)
+		}
+	}
+	b.WriteString(")\n")
+
+	for _, name := range names {
+		info := infos[name]
+		typ, ok := optionType(name, info)
+		if !ok {
+			continue
+		}
+
+		method := methodName(name)
+		constant := constName(name)
+
+		fmt.Fprintf(&b, "\n// %s returns the value of '%s' (%s).\n", method, name, info.Scope)
+		fmt.Fprintf(&b, "func (o OptionValues) %s() %s {\n\treturn %s.Get(o)\n}\n", method, typ, constant)
+		fmt.Fprintf(&b, "\n// Set%s sets the value of '%s' (%s).\n", method, name, info.Scope)
+		fmt.Fprintf(&b, "func (o OptionValues) Set%s(value %s) {\n\t%s.Set(o, value)\n}\n", method, typ, constant)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func optionInfos(path string) (map[string]nvim.OptionInfo, error) {
+	infos := map[string]nvim.OptionInfo{}
+
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return infos, json.Unmarshal(data, &infos)
+	}
+
+	v, err := nvim.NewChildProcess(nvim.ChildProcessArgs("-u", "NONE", "-n", "--embed", "--headless"))
+	if err != nil {
+		return nil, err
+	}
+	defer v.Close()
+
+	return infos, v.Request("nvim_get_all_options_info", &infos)
+}
+
+// declaredNames returns the top level names and the methods ("Type.Method")
+// declared in the package in `dir`, except in the file `skip`.
+func declaredNames(dir, skip string) (map[string]bool, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, pkg := range pkgs {
+		for path, file := range pkg.Files {
+			if filepath.Base(path) == skip {
+				continue
+			}
+			for _, decl := range file.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if d.Recv == nil {
+						names[d.Name.Name] = true
+						continue
+					}
+					recv := d.Recv.List[0].Type
+					if star, ok := recv.(*ast.StarExpr); ok {
+						recv = star.X
+					}
+					if ident, ok := recv.(*ast.Ident); ok {
+						names[ident.Name+"."+d.Name.Name] = true
+					}
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *ast.TypeSpec:
+							names[s.Name.Name] = true
+						case *ast.ValueSpec:
+							for _, n := range s.Names {
+								names[n.Name] = true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+// optionType returns the Go type of the values of the option.
+func optionType(name string, info nvim.OptionInfo) (string, bool) {
+	if enum, ok := enums[name]; ok {
+		return enum, true
+	}
+	typ, ok := types[info.Type]
+	return typ, ok
+}
+
+func methodName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func constName(name string) string {
+	return "Option" + methodName(name)
+}
@@ -0,0 +1,110 @@
+package neovim
+
+type SelectOptions struct {
+	Prompt string `msgpack:"prompt,omitempty"`
+
+	// Kind hints the type of the items to vim.ui.select() overrides, e.g.
+	// "codeaction".
+	Kind string `msgpack:"kind,omitempty"`
+}
+
+// Select lets the user pick one of `items` with vim.ui.select(), so that user
+// overrides (like telescope or dressing) are used. It blocks until the user
+// picks an item and returns its index, or false if the selection was
+// cancelled.
+//
+// Neovim cannot show the selection while it waits for a handler or a function
+// registered with Api.Function() to return. Select must not be called from
+// these, or from goroutines they wait for; start a goroutine instead:
+//
+//	api.Global.KeyMaps.SetFunc(neovim.ModeNormal, "<leader>b", func() {
+//	  go func() {
+//	    if idx, ok := api.Select(names, neovim.SelectOptions{Prompt: "Buffer"}); ok {
+//	      open(names[idx])
+//	    }
+//	  }()
+//	})
+func (api *Api) Select(items []string, opts SelectOptions) (int, bool) {
+	if len(items) == 0 {
+		return 0, false
+	}
+
+	result, ok := api.ui(`
+		local fn, id, items, opts = ...
+		vim.schedule(function()
+			vim.ui.select(items, opts, function(_, idx)
+				vim.fn[fn](id, idx)
+			end)
+		end)
+	`, items, opts)
+	if !ok {
+		return 0, false
+	}
+
+	idx, ok := toInt(result)
+	if !ok || idx < 1 || idx > len(items) {
+		return 0, false
+	}
+
+	return idx - 1, true
+}
+
+// Input asks the user for a value with vim.ui.input(). It blocks until the user
+// confirms the input, and returns false if it was cancelled. Like Select, it
+// must not be called from handlers directly.
+func (api *Api) Input(prompt, defaultValue string) (string, bool) {
+	result, ok := api.ui(`
+		local fn, id, prompt, default = ...
+		vim.schedule(function()
+			vim.ui.input({ prompt = prompt, default = default }, function(input)
+				vim.fn[fn](id, input)
+			end)
+		end)
+	`, prompt, defaultValue)
+	if !ok {
+		return "", false
+	}
+
+	value, ok := result.(string)
+	return value, ok
+}
+
+// ui runs `code` with a handler that receives the result of a vim.ui function,
+// and waits for it. `code` schedules the vim.ui function, so that it runs once
+// neovim is no longer busy with requests. The result is false if the handler
+// was called without an argument.
+func (api *Api) ui(code string, args ...interface{}) (interface{}, bool) {
+	results := make(chan []interface{}, 1)
+
+	handler := api.Handler.Create(func(args ...interface{}) {
+		results <- args
+	})
+	defer handler.Dispose()
+
+	args = append([]interface{}{handler.functionName, handler.uuid}, args...)
+	if err := api.nvim().ExecLua(code, nil, args...); err != nil {
+		return nil, false
+	}
+
+	result := <-results
+	if len(result) == 0 || result[0] == nil {
+		return nil, false
+	}
+
+	return result[0], true
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
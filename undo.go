@@ -0,0 +1,92 @@
+package neovim
+
+const undojoinSetLinesLua = `
+	local buf, lines = ...
+	vim.api.nvim_buf_call(buf, function()
+		-- undojoin fails right after an undo, the change gets its own entry then
+		pcall(vim.cmd, 'undojoin')
+		vim.api.nvim_buf_set_lines(buf, 0, -1, false, lines)
+	end)
+`
+
+// undoGroup tracks the Undoable() calls of a buffer.
+type undoGroup struct {
+	depth   int
+	changed bool
+}
+
+// join reports whether the next change should be joined with the previous one.
+// It must be called with the buffer lock held.
+func (u *undoGroup) join() bool {
+	if u.depth == 0 {
+		return false
+	}
+
+	join := u.changed
+	u.changed = true
+	return join
+}
+
+// Undoable runs `fn` and groups all changes it makes to the buffer with
+// SetLines() and OperatorContext.SetText() into one undo step. Changes made in
+// other ways, e.g. with normal mode commands, are not joined.
+func (b *Buffer) Undoable(fn func()) {
+	b.mutex.Lock()
+	if b.undo.depth == 0 {
+		b.undo.changed = false
+	}
+	b.undo.depth++
+	b.mutex.Unlock()
+
+	defer func() {
+		b.mutex.Lock()
+		b.undo.depth--
+		b.mutex.Unlock()
+	}()
+
+	fn()
+}
+
+// WithoutUndo runs `fn` with 'undolevels' set to -1. The changes cannot be
+// undone and, as with any change made with 'undolevels' -1, they clear the
+// whole undo history of the buffer. It is meant for buffers without history
+// worth keeping, e.g. scratch buffers that are filled by the plugin.
+func (b *Buffer) WithoutUndo(fn func()) {
+	b.api.WithOptions([]Override{
+		OptionOverride(b.Options.Values(), string(BufferOptionUndoLevels), -1),
+	}, fn)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Undo Tree
+
+type UndoEntry struct {
+	Seq     int         `msgpack:"seq"`
+	Time    int64       `msgpack:"time"`
+	NewHead bool        `msgpack:"newhead"`
+	CurHead bool        `msgpack:"curhead"`
+	Save    int         `msgpack:"save"`
+	Alt     []UndoEntry `msgpack:"alt"`
+}
+
+type UndoTree struct {
+	SeqLast  int         `msgpack:"seq_last"`
+	SeqCur   int         `msgpack:"seq_cur"`
+	TimeCur  int64       `msgpack:"time_cur"`
+	SaveLast int         `msgpack:"save_last"`
+	SaveCur  int         `msgpack:"save_cur"`
+	Synced   bool        `msgpack:"synced"`
+	Entries  []UndoEntry `msgpack:"entries"`
+}
+
+// UndoTree returns the state of the undo tree of the buffer (see undotree()).
+func (b *Buffer) UndoTree() UndoTree {
+	var tree UndoTree
+	b.api.nvim().ExecLua(`
+		local buf = ...
+		return vim.api.nvim_buf_call(buf, function()
+			return vim.fn.undotree()
+		end)
+	`, &tree, b.id)
+	return tree
+}
@@ -1,10 +1,18 @@
 package neovim
 
 import (
+	"fmt"
+
 	"github.com/neovim/go-client/nvim"
 )
 
 type Vars struct {
+	api *Api
+
+	// "g", "b", "w", "t", "v" or "env"
+	scope string
+	id    int
+
 	get    func(name string, result interface{}) error
 	set    func(name string, value interface{}) error
 	delete func(name string) error
@@ -12,6 +20,9 @@ type Vars struct {
 
 func newBufferVars(api *Api, id nvim.Buffer) Vars {
 	return Vars{
+		api:   api,
+		scope: "b",
+		id:    int(id),
 		get: func(name string, result interface{}) error {
 			return api.nvim().BufferVar(id, name, result)
 		},
@@ -26,6 +37,9 @@ func newBufferVars(api *Api, id nvim.Buffer) Vars {
 
 func newWindowVars(api *Api, id nvim.Window) Vars {
 	return Vars{
+		api:   api,
+		scope: "w",
+		id:    int(id),
 		get: func(name string, result interface{}) error {
 			return api.nvim().WindowVar(id, name, result)
 		},
@@ -40,6 +54,9 @@ func newWindowVars(api *Api, id nvim.Window) Vars {
 
 func newTabVars(api *Api, id nvim.Tabpage) Vars {
 	return Vars{
+		api:   api,
+		scope: "t",
+		id:    int(id),
 		get: func(name string, result interface{}) error {
 			return api.nvim().TabpageVar(id, name, result)
 		},
@@ -54,6 +71,8 @@ func newTabVars(api *Api, id nvim.Tabpage) Vars {
 
 func newGlobalVars(api *Api) Vars {
 	return Vars{
+		api:   api,
+		scope: "g",
 		get: func(name string, result interface{}) error {
 			return api.nvim().Var(name, result)
 		},
@@ -66,6 +85,70 @@ func newGlobalVars(api *Api) Vars {
 	}
 }
 
+func newVimVars(api *Api) Vars {
+	return Vars{
+		api:   api,
+		scope: "v",
+		get: func(name string, result interface{}) error {
+			return api.nvim().VVar(name, result)
+		},
+		set: func(name string, value interface{}) error {
+			return api.nvim().SetVVar(name, value)
+		},
+		delete: func(name string) error {
+			return fmt.Errorf("cannot delete v:%s", name)
+		},
+	}
+}
+
+func newEnvVars(api *Api) Vars {
+	return Vars{
+		api:   api,
+		scope: "env",
+		get: func(name string, result interface{}) error {
+			return api.nvim().ExecLua(`
+				local name = ...
+				local value = vim.env[name]
+				if value == nil then
+					error('Environment variable not found: ' .. name)
+				end
+				return value
+			`, result, name)
+		},
+		set: func(name string, value interface{}) error {
+			return api.nvim().ExecLua(`
+				local name, value = ...
+				vim.env[name] = value
+			`, nil, name, fmt.Sprint(value))
+		},
+		delete: func(name string) error {
+			return api.nvim().ExecLua(`
+				local name = ...
+				vim.env[name] = nil
+			`, nil, name)
+		},
+	}
+}
+
+// Get reads the variable `name` into `result`, which can be any type that can
+// be decoded from msgpack, including structs with msgpack tags. An error is
+// returned if the variable does not exist or has a different type.
+func (v *Vars) Get(name string, result interface{}) error {
+	return v.get(name, result)
+}
+
+// Set sets the variable `name` to `value`. Structs are converted to
+// dictionaries, using their msgpack tags as keys.
+func (v *Vars) Set(name string, value interface{}) error {
+	return v.set(name, value)
+}
+
+// Has reports whether the variable `name` exists.
+func (v *Vars) Has(name string) bool {
+	var value interface{}
+	return v.get(name, &value) == nil
+}
+
 func (v *Vars) String(name string) string {
 	var value string
 	v.get(name, &value)
@@ -99,4 +182,3 @@ func (v *Vars) SetInt(name string, value int) {
 func (v *Vars) Delete(name string) {
 	v.delete(name)
 }
-
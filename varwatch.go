@@ -0,0 +1,95 @@
+package neovim
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/josa42/go-neovim/disposables"
+)
+
+const varOwnerLua = `
+	local group, scope, id, fn, hid = ...
+	local opts = {
+		group = vim.api.nvim_create_augroup(group, { clear = true }),
+		callback = function() vim.fn[fn](hid) end,
+	}
+
+	if scope == 'b' then
+		opts.buffer = id
+		vim.api.nvim_create_autocmd('BufWipeout', opts)
+	elseif scope == 'w' then
+		opts.pattern = tostring(id)
+		vim.api.nvim_create_autocmd('WinClosed', opts)
+	elseif scope == 't' then
+		opts.callback = function()
+			if not vim.api.nvim_tabpage_is_valid(id) then
+				vim.fn[fn](hid)
+			end
+		end
+		vim.api.nvim_create_autocmd('TabClosed', opts)
+	end
+`
+
+// dict returns a Vimscript expression that evaluates to the dictionary of the
+// variables.
+func (v *Vars) dict() (string, bool) {
+	switch v.scope {
+	case "g":
+		return "g:", true
+	case "b":
+		return fmt.Sprintf("getbufvar(%d, '')", v.id), true
+	case "w":
+		return fmt.Sprintf("gettabwinvar(win_id2tabwin(%d)[0], %d, '')", v.id, v.id), true
+	case "t":
+		return fmt.Sprintf("gettabvar(nvim_tabpage_get_number(%d), '')", v.id), true
+	}
+	return "", false
+}
+
+// Watch calls `fn` whenever the variable `name` is set, changed or deleted
+// (see dictwatcheradd()). `old` is nil if the variable has been added, `new` is
+// nil if it has been deleted. Only g:, b:, w: and t: variables can be watched.
+// The watcher is removed when the buffer, window or tab is closed.
+func (v *Vars) Watch(name string, fn func(old, new interface{})) disposables.Disposable {
+	dict, ok := v.dict()
+	if !ok {
+		log.Printf("Vars.Watch(): cannot watch %s: variables", v.scope)
+		return disposables.New(func() {})
+	}
+
+	var d disposables.Disposable
+	handler := v.api.Handler.Create(func(args ...interface{}) {
+		if len(args) == 0 {
+			d.Dispose()
+			return
+		}
+		if len(args) == 3 {
+			fn(args[1], args[2])
+		}
+	})
+
+	groupName := fmt.Sprintf("var_%s", handler.uuid)
+	funcName := fmt.Sprintf("GoNeovimVarWatch_%s", handler.uuid)
+
+	v.api.nvim().Exec(fmt.Sprintf(
+		"function! %s(dict, key, change)\n call %s\nendfunction\ncall dictwatcheradd(%s, '%s', '%s')",
+		funcName,
+		handler.StringWithEvals("a:key", "get(a:change, 'old', v:null)", "get(a:change, 'new', v:null)"),
+		dict, name, funcName,
+	), false)
+
+	v.api.nvim().ExecLua(varOwnerLua, nil, groupName, v.scope, v.id, handler.functionName, handler.uuid)
+
+	once := sync.Once{}
+	d = disposables.New(func() {
+		once.Do(func() {
+			handler.Dispose()
+			v.api.Executef("silent! autocmd! %s", groupName)
+			v.api.Executef("silent! call dictwatcherdel(%s, '%s', '%s')", dict, name, funcName)
+			v.api.Executef("silent! delfunction! %s", funcName)
+		})
+	})
+
+	return d
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type match struct {
+	score int
+	// byte offsets of the matched characters
+	positions []int
+}
+
+// fuzzyMatch matches the characters of `query` in order against `text`. The
+// match is case sensitive only if `query` contains upper case characters.
+func fuzzyMatch(query, text string) (match, bool) {
+	if query == "" {
+		return match{}, true
+	}
+
+	ignoreCase := strings.ToLower(query) == query
+	q := []rune(query)
+
+	m := match{positions: make([]int, 0, len(q))}
+	qi := 0
+	prev := rune(0)
+	last := -1
+
+	for i, r := range text {
+		if qi == len(q) {
+			break
+		}
+
+		c := r
+		if ignoreCase {
+			c = unicode.ToLower(r)
+		}
+
+		if c == q[qi] {
+			m.score++
+			if last >= 0 && last+utf8.RuneLen(prev) == i {
+				m.score += 5
+			} else if last >= 0 {
+				m.score -= min(i-last, 5)
+			}
+			if isWordStart(prev, r) {
+				m.score += 8
+			}
+
+			m.positions = append(m.positions, i)
+			last = i
+			qi++
+		}
+
+		prev = r
+	}
+
+	return m, qi == len(q)
+}
+
+func isWordStart(prev, r rune) bool {
+	switch {
+	case prev == 0:
+		return true
+	case strings.ContainsRune("/\\_-. ", prev):
+		return true
+	case unicode.IsLower(prev) && unicode.IsUpper(r):
+		return true
+	default:
+		return false
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
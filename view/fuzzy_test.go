@@ -0,0 +1,56 @@
+package view
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		query     string
+		text      string
+		ok        bool
+		positions []int
+	}{
+		{"", "main.go", true, nil},
+		{"mg", "main.go", true, []int{0, 5}},
+		{"mgo", "main.go", true, []int{0, 5, 6}},
+		{"MG", "main.go", false, nil},
+		{"Mg", "Main.go", true, []int{0, 5}},
+		{"go", "fuzzy", false, nil},
+		{"äb", "xäb", true, []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		m, ok := fuzzyMatch(tt.query, tt.text)
+		if ok != tt.ok {
+			t.Errorf("fuzzyMatch(%q, %q) ok = %v, want %v", tt.query, tt.text, ok, tt.ok)
+			continue
+		}
+		if ok && len(tt.positions) > 0 && !reflect.DeepEqual(m.positions, tt.positions) {
+			t.Errorf("fuzzyMatch(%q, %q) positions = %v, want %v", tt.query, tt.text, m.positions, tt.positions)
+		}
+	}
+}
+
+func TestFuzzyMatchScore(t *testing.T) {
+	tests := []struct {
+		query  string
+		better string
+		worse  string
+	}{
+		// consecutive characters
+		{"buf", "buffer.go", "bxuxf.go"},
+		// start of words
+		{"kh", "keymap_help.go", "keymaphelp.go"},
+		{"kh", "KeyHelp.go", "kahelp.go"},
+	}
+
+	for _, tt := range tests {
+		better, _ := fuzzyMatch(tt.query, tt.better)
+		worse, _ := fuzzyMatch(tt.query, tt.worse)
+		if better.score <= worse.score {
+			t.Errorf("fuzzyMatch(%q): score of %q (%d) should be higher than %q (%d)", tt.query, tt.better, better.score, tt.worse, worse.score)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/josa42/go-neovim"
+	"github.com/josa42/go-neovim/disposables"
+)
+
+const (
+	KeyMapHelpFileType = "keymaphelp"
+
+	keyMapHelpSeparator = " → "
+	keyMapHelpGap       = "    "
+
+	// descriptions are truncated to this width
+	keyMapHelpDescWidth = 40
+)
+
+var keyMapHelpModes = map[neovim.Mode]string{
+	neovim.ModeAll:      "normal, visual, operator-pending",
+	neovim.ModeNormal:   "normal",
+	neovim.ModeVisual:   "visual, select",
+	neovim.Mode("x"):    "visual",
+	neovim.Mode("s"):    "select",
+	neovim.Mode("o"):    "operator-pending",
+	neovim.ModeInsert:   "insert",
+	neovim.Mode("c"):    "command-line",
+	neovim.ModeTerminal: "terminal",
+}
+
+// Interface Assertions
+var _ neovim.View = (*KeyMapHelp)(nil)
+var _ disposables.Disposable = (*KeyMapHelp)(nil)
+
+// KeyMapHelp lists the mappings of all go-neovim plugins, grouped by plugin and
+// mode, in a floating window at the bottom of the editor.
+type KeyMapHelp struct {
+	api      *neovim.Api
+	filter   func(neovim.KeyMapEntry) bool
+	renderer neovim.ViewRenderer
+
+	mutex      sync.Mutex
+	lines      []string
+	highlights []neovim.Highlight
+	closed     bool
+
+	namespace int
+	buffer    *neovim.Buffer
+	window    *neovim.Window
+
+	disposables *disposables.Collection
+}
+
+// NewKeyMapHelp creates a help view for the mappings `filter` returns true
+// for. A nil filter shows the global mappings and the buffer-local mappings of
+// the current buffer.
+func NewKeyMapHelp(api *neovim.Api, filter func(neovim.KeyMapEntry) bool) *KeyMapHelp {
+	if filter == nil {
+		current := api.CurrentBuffer().ID()
+		filter = func(e neovim.KeyMapEntry) bool {
+			return e.Buffer == 0 || e.Buffer == current
+		}
+	}
+
+	return &KeyMapHelp{
+		api:         api,
+		filter:      filter,
+		namespace:   api.Namespace("go-neovim-keymaphelp"),
+		disposables: disposables.NewCollection(),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// View
+
+func (h *KeyMapHelp) FileType() string {
+	return KeyMapHelpFileType
+}
+
+func (h *KeyMapHelp) Attach(r neovim.ViewRenderer) {
+	h.renderer = r
+}
+
+func (h *KeyMapHelp) Lines() []string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.lines
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Life Cycle
+
+// Open shows the mappings.
+func (h *KeyMapHelp) Open() error {
+	api := h.api
+
+	columns := api.Global.Options.Columns()
+	lines := api.Global.Options.Lines()
+	width := columns - 2
+
+	entries := []neovim.KeyMapEntry{}
+	for _, e := range api.KeyMapRegistry.List() {
+		if h.filter(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	h.mutex.Lock()
+	h.lines, h.highlights = keyMapHelpLayout(entries, width)
+	if len(h.lines) == 0 {
+		h.lines = []string{"No mappings"}
+	}
+	height := min(len(h.lines), lines/2)
+	h.mutex.Unlock()
+
+	h.buffer = api.CreateBuffer(false, true)
+	api.Renderer.Attach(h.buffer, h)
+
+	var err error
+	h.window, err = api.OpenFloat(h.buffer, neovim.FloatConfig{
+		Row:       float64(lines - height - 4),
+		Col:       0,
+		Width:     width,
+		Height:    height,
+		Border:    neovim.FloatBorderRounded,
+		Title:     "Mappings",
+		Focusable: true,
+		Style:     neovim.FloatStyleMinimal,
+		Enter:     true,
+	})
+	if err != nil {
+		h.Close()
+		return err
+	}
+
+	h.buffer.AddHighlights(h.namespace, h.highlights)
+
+	h.buffer.On(neovim.EventBufLeave, func() {
+		go h.Close()
+	})
+
+	for _, keys := range []string{"q", "<Esc>"} {
+		h.disposables.Add(h.buffer.KeyMaps.SetFunc(neovim.ModeNormal, keys, h.Close))
+	}
+
+	return nil
+}
+
+// Close closes the window of the help view.
+func (h *KeyMapHelp) Close() {
+	h.mutex.Lock()
+	if h.closed {
+		h.mutex.Unlock()
+		return
+	}
+	h.closed = true
+	h.mutex.Unlock()
+
+	if h.window != nil {
+		h.window.Close(true)
+	}
+	if h.buffer != nil {
+		h.buffer.Close()
+	}
+
+	h.disposables.Dispose()
+}
+
+func (h *KeyMapHelp) Dispose() {
+	h.Close()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Layout
+
+// keyMapHelpLayout arranges the mappings of each plugin and mode in as many
+// columns as fit into `width`.
+func keyMapHelpLayout(entries []neovim.KeyMapEntry, width int) ([]string, []neovim.Highlight) {
+	lines := []string{}
+	highlights := []neovim.Highlight{}
+
+	for start := 0; start < len(entries); {
+		end := start
+		for end < len(entries) && entries[end].Plugin == entries[start].Plugin && entries[end].Mode == entries[start].Mode {
+			end++
+		}
+		group := entries[start:end]
+		start = end
+
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+
+		header := fmt.Sprintf("%s (%s)", group[0].Plugin, keyMapHelpMode(group[0].Mode))
+		lines = append(lines, header)
+		highlights = append(highlights, neovim.Highlight{Group: "Title", Line: len(lines), StartCol: 0, EndCol: len(header)})
+
+		lhsWidth, descWidth := 0, 0
+		for _, e := range group {
+			lhsWidth = max(lhsWidth, utf8.RuneCountInString(e.LHS))
+			descWidth = max(descWidth, utf8.RuneCountInString(keyMapHelpDesc(e)))
+		}
+
+		itemWidth := lhsWidth + utf8.RuneCountInString(keyMapHelpSeparator) + descWidth
+		cols := max(1, (width+len(keyMapHelpGap))/(itemWidth+len(keyMapHelpGap)))
+		rows := (len(group) + cols - 1) / cols
+
+		for row := 0; row < rows; row++ {
+			b := strings.Builder{}
+			b.WriteString("  ")
+
+			for col := 0; col < cols; col++ {
+				idx := col*rows + row
+				if idx >= len(group) {
+					break
+				}
+				e := group[idx]
+
+				if col > 0 {
+					b.WriteString(keyMapHelpGap)
+				}
+
+				startCol := b.Len()
+				b.WriteString(e.LHS)
+				highlights = append(highlights, neovim.Highlight{Group: "Special", Line: len(lines) + 1, StartCol: startCol, EndCol: b.Len()})
+
+				b.WriteString(strings.Repeat(" ", lhsWidth-utf8.RuneCountInString(e.LHS)))
+				b.WriteString(keyMapHelpSeparator)
+
+				desc := keyMapHelpDesc(e)
+				b.WriteString(desc)
+				if col < cols-1 {
+					b.WriteString(strings.Repeat(" ", descWidth-utf8.RuneCountInString(desc)))
+				}
+			}
+
+			lines = append(lines, strings.TrimRight(b.String(), " "))
+		}
+	}
+
+	return lines, highlights
+}
+
+func keyMapHelpMode(mode neovim.Mode) string {
+	if name, ok := keyMapHelpModes[mode]; ok {
+		return name
+	}
+	return string(mode)
+}
+
+func keyMapHelpDesc(e neovim.KeyMapEntry) string {
+	desc := e.Desc
+	if desc == "" {
+		desc = "-"
+	}
+
+	if utf8.RuneCountInString(desc) > keyMapHelpDescWidth {
+		desc = string([]rune(desc)[:keyMapHelpDescWidth-1]) + "…"
+	}
+	return desc
+}
@@ -0,0 +1,89 @@
+package view
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/josa42/go-neovim"
+)
+
+func TestKeyMapHelpLayout(t *testing.T) {
+	normal := []neovim.KeyMapEntry{
+		{Plugin: "p", Mode: neovim.ModeNormal, LHS: "a", Desc: "Alpha"},
+		{Plugin: "p", Mode: neovim.ModeNormal, LHS: "bb"},
+	}
+	visual := neovim.KeyMapEntry{Plugin: "p", Mode: neovim.Mode("x"), LHS: "c", Desc: "Gamma"}
+
+	tests := []struct {
+		name       string
+		entries    []neovim.KeyMapEntry
+		width      int
+		lines      []string
+		highlights []neovim.Highlight
+	}{
+		{
+			name:    "columns",
+			entries: normal,
+			width:   30,
+			lines: []string{
+				"p (normal)",
+				"  a  → Alpha    bb → -",
+			},
+			highlights: []neovim.Highlight{
+				{Group: "Title", Line: 1, StartCol: 0, EndCol: 10},
+				{Group: "Special", Line: 2, StartCol: 2, EndCol: 3},
+				{Group: "Special", Line: 2, StartCol: 18, EndCol: 20},
+			},
+		},
+		{
+			name:    "rows",
+			entries: normal,
+			width:   10,
+			lines: []string{
+				"p (normal)",
+				"  a  → Alpha",
+				"  bb → -",
+			},
+			highlights: []neovim.Highlight{
+				{Group: "Title", Line: 1, StartCol: 0, EndCol: 10},
+				{Group: "Special", Line: 2, StartCol: 2, EndCol: 3},
+				{Group: "Special", Line: 3, StartCol: 2, EndCol: 4},
+			},
+		},
+		{
+			name:    "groups",
+			entries: append(append([]neovim.KeyMapEntry{}, normal[:1]...), visual),
+			width:   30,
+			lines: []string{
+				"p (normal)",
+				"  a → Alpha",
+				"",
+				"p (visual)",
+				"  c → Gamma",
+			},
+			highlights: []neovim.Highlight{
+				{Group: "Title", Line: 1, StartCol: 0, EndCol: 10},
+				{Group: "Special", Line: 2, StartCol: 2, EndCol: 3},
+				{Group: "Title", Line: 4, StartCol: 0, EndCol: 10},
+				{Group: "Special", Line: 5, StartCol: 2, EndCol: 3},
+			},
+		},
+		{
+			name:       "empty",
+			entries:    nil,
+			width:      30,
+			lines:      []string{},
+			highlights: []neovim.Highlight{},
+		},
+	}
+
+	for _, tt := range tests {
+		lines, highlights := keyMapHelpLayout(tt.entries, tt.width)
+		if !reflect.DeepEqual(lines, tt.lines) {
+			t.Errorf("%s: lines = %q, want %q", tt.name, lines, tt.lines)
+		}
+		if !reflect.DeepEqual(highlights, tt.highlights) {
+			t.Errorf("%s: highlights = %v, want %v", tt.name, highlights, tt.highlights)
+		}
+	}
+}
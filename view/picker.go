@@ -0,0 +1,548 @@
+package view
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/josa42/go-neovim"
+	"github.com/josa42/go-neovim/disposables"
+)
+
+const (
+	PickerFileType = "picker"
+
+	pickerPrefix       = "  "
+	pickerPrefixMarked = "* "
+
+	// maximum number of lines that get match highlights
+	pickerHighlightLimit = 200
+)
+
+type PickerItem interface {
+	String() string
+}
+
+// PickerFile is an item that can be opened by the OpenFiles() actions.
+type PickerFile interface {
+	PickerItem
+	Path() string
+}
+
+type PickerSource interface {
+	// Items sends the items of the source to `items`. It is called in its own
+	// goroutine and should return early once `done` is closed.
+	Items(items chan<- PickerItem, done <-chan struct{})
+}
+
+type PickerAction struct {
+	Keys    string
+	Handler func(items []PickerItem)
+}
+
+type PickerOptions struct {
+	Title string
+
+	// Multi allows to mark multiple items with <Tab>.
+	Multi bool
+
+	// OnAccept is called with the selected items on <CR>. It defaults to opening
+	// PickerFile items.
+	OnAccept func(items []PickerItem)
+
+	// Actions are mapped in the prompt. They default to opening PickerFile items
+	// in a split (<C-x>), vertical split (<C-v>) or tab (<C-t>).
+	Actions []PickerAction
+}
+
+type pickerMatch struct {
+	match
+	idx int
+}
+
+// Interface Assertions
+var _ neovim.View = (*Picker)(nil)
+var _ disposables.Disposable = (*Picker)(nil)
+
+// Picker lets the user fuzzy filter the items of a PickerSource. It shows a
+// prompt and the results in floating windows.
+type Picker struct {
+	api      *neovim.Api
+	source   PickerSource
+	options  PickerOptions
+	renderer neovim.ViewRenderer
+
+	mutex   sync.Mutex
+	items   []PickerItem
+	matches []pickerMatch
+	marked  map[int]bool
+	query   string
+	current int
+	closed  bool
+
+	renderMutex sync.Mutex
+	namespace   int
+
+	origin     *neovim.Window
+	prompt     *neovim.Buffer
+	promptWin  *neovim.Window
+	results    *neovim.Buffer
+	resultsWin *neovim.Window
+
+	done        chan struct{}
+	disposables *disposables.Collection
+}
+
+func NewPicker(api *neovim.Api, source PickerSource, options PickerOptions) *Picker {
+	if options.OnAccept == nil {
+		options.OnAccept = OpenFiles(api, PickerOpenEdit)
+	}
+
+	if options.Actions == nil {
+		options.Actions = []PickerAction{
+			{Keys: "<C-x>", Handler: OpenFiles(api, PickerOpenSplit)},
+			{Keys: "<C-v>", Handler: OpenFiles(api, PickerOpenVSplit)},
+			{Keys: "<C-t>", Handler: OpenFiles(api, PickerOpenTab)},
+		}
+	}
+
+	return &Picker{
+		api:         api,
+		source:      source,
+		options:     options,
+		marked:      map[int]bool{},
+		namespace:   api.Namespace("go-neovim-picker"),
+		done:        make(chan struct{}),
+		disposables: disposables.NewCollection(),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// View
+
+func (p *Picker) FileType() string {
+	return PickerFileType
+}
+
+func (p *Picker) Attach(r neovim.ViewRenderer) {
+	p.renderer = r
+}
+
+func (p *Picker) Lines() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	lines := []string{}
+	for _, m := range p.matches {
+		lines = append(lines, p.prefix(m.idx)+p.items[m.idx].String())
+	}
+
+	return lines
+}
+
+func (p *Picker) prefix(idx int) string {
+	if p.marked[idx] {
+		return pickerPrefixMarked
+	}
+	return pickerPrefix
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Life Cycle
+
+// Open shows the picker and starts reading the items of the source.
+func (p *Picker) Open() error {
+	api := p.api
+	p.origin = api.CurrentWindow()
+
+	columns := api.Global.Options.Columns()
+	lines := api.Global.Options.Lines()
+
+	width := columns * 3 / 5
+	height := lines/2 - 3
+	row := float64(lines-height) / 2
+	col := float64(columns-width) / 2
+
+	var err error
+
+	p.results = api.CreateBuffer(false, true)
+	api.Renderer.Attach(p.results, p)
+
+	p.resultsWin, err = api.OpenFloat(p.results, neovim.FloatConfig{
+		Row:    row - 2,
+		Col:    col,
+		Width:  width,
+		Height: height,
+		Border: neovim.FloatBorderRounded,
+		Title:  p.options.Title,
+		Style:  neovim.FloatStyleMinimal,
+	})
+	if err != nil {
+		p.Close()
+		return err
+	}
+	p.resultsWin.Options.SetCursorLine(true)
+
+	p.prompt = api.CreateBuffer(false, true)
+	p.promptWin, err = api.OpenFloat(p.prompt, neovim.FloatConfig{
+		Row:       row + float64(height),
+		Col:       col,
+		Width:     width,
+		Height:    1,
+		Border:    neovim.FloatBorderRounded,
+		Focusable: true,
+		Style:     neovim.FloatStyleMinimal,
+		Enter:     true,
+	})
+	if err != nil {
+		p.Close()
+		return err
+	}
+
+	p.initializePrompt()
+	api.Execute("startinsert")
+
+	go p.stream()
+
+	return nil
+}
+
+func (p *Picker) initializePrompt() {
+	b := p.prompt
+
+	b.On(neovim.EventTextChangedI, func() {
+		lines := b.Lines()
+		if len(lines) > 0 {
+			p.setQuery(lines[0])
+		}
+	})
+
+	b.On(neovim.EventBufLeave, func() {
+		go p.Close()
+	})
+
+	p.mapKey("<CR>", func() { p.accept(p.options.OnAccept) })
+	p.mapKey("<Esc>", p.Close)
+	p.mapKey("<C-c>", p.Close)
+	p.mapKey("<C-n>", func() { p.move(1) })
+	p.mapKey("<Down>", func() { p.move(1) })
+	p.mapKey("<C-p>", func() { p.move(-1) })
+	p.mapKey("<Up>", func() { p.move(-1) })
+
+	if p.options.Multi {
+		p.mapKey("<Tab>", p.toggleMark)
+	}
+
+	for _, a := range p.options.Actions {
+		func(a PickerAction) {
+			p.mapKey(a.Keys, func() { p.accept(a.Handler) })
+		}(a)
+	}
+}
+
+func (p *Picker) mapKey(keys string, fn func()) {
+	handler := p.api.Handler.Create(fn)
+	p.disposables.Add(handler)
+	p.prompt.KeyMaps.Setf(neovim.ModeInsert, keys, `<Cmd>call %s<CR>`, handler)
+}
+
+// Close closes the windows of the picker and stops reading the source.
+func (p *Picker) Close() {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	p.mutex.Unlock()
+
+	p.api.Execute("stopinsert")
+
+	for _, w := range []*neovim.Window{p.promptWin, p.resultsWin} {
+		if w != nil {
+			w.Close(true)
+		}
+	}
+	for _, b := range []*neovim.Buffer{p.prompt, p.results} {
+		if b != nil {
+			b.Close()
+		}
+	}
+
+	p.disposables.Dispose()
+}
+
+func (p *Picker) Dispose() {
+	p.Close()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Items
+
+func (p *Picker) stream() {
+	items := make(chan PickerItem)
+
+	go func() {
+		defer close(items)
+		p.source.Items(items, p.done)
+	}()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	pending := []PickerItem{}
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		p.mutex.Lock()
+		p.items = append(p.items, pending...)
+		p.filter()
+		p.mutex.Unlock()
+
+		pending = []PickerItem{}
+		p.rerender()
+	}
+
+	for {
+		select {
+		case item, ok := <-items:
+			if !ok {
+				flush()
+				return
+			}
+			pending = append(pending, item)
+
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// filter must be called with the mutex held.
+func (p *Picker) filter() {
+	if p.closed {
+		return
+	}
+
+	matches := []pickerMatch{}
+	for idx, item := range p.items {
+		if m, ok := fuzzyMatch(p.query, item.String()); ok {
+			matches = append(matches, pickerMatch{match: m, idx: idx})
+		}
+	}
+
+	if p.query != "" {
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].score > matches[j].score
+		})
+	}
+
+	p.matches = matches
+	if p.current >= len(matches) {
+		p.current = max(len(matches)-1, 0)
+	}
+}
+
+func (p *Picker) setQuery(query string) {
+	p.mutex.Lock()
+	if query == p.query {
+		p.mutex.Unlock()
+		return
+	}
+	p.query = query
+	p.current = 0
+	p.filter()
+	p.mutex.Unlock()
+
+	p.rerender()
+}
+
+func (p *Picker) move(delta int) {
+	p.mutex.Lock()
+	if len(p.matches) > 0 {
+		p.current = (p.current + delta + len(p.matches)) % len(p.matches)
+	}
+	p.mutex.Unlock()
+
+	p.updateCursor()
+}
+
+func (p *Picker) toggleMark() {
+	p.mutex.Lock()
+	if p.current < len(p.matches) {
+		idx := p.matches[p.current].idx
+		if p.marked[idx] {
+			delete(p.marked, idx)
+		} else {
+			p.marked[idx] = true
+		}
+		p.current = min(p.current+1, len(p.matches)-1)
+	}
+	p.mutex.Unlock()
+
+	p.rerender()
+}
+
+// Selected returns the marked items, or the item under the cursor if no item is
+// marked.
+func (p *Picker) Selected() []PickerItem {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	selected := []PickerItem{}
+	for idx, item := range p.items {
+		if p.marked[idx] {
+			selected = append(selected, item)
+		}
+	}
+
+	if len(selected) == 0 && p.current < len(p.matches) {
+		selected = append(selected, p.items[p.matches[p.current].idx])
+	}
+
+	return selected
+}
+
+func (p *Picker) accept(fn func([]PickerItem)) {
+	items := p.Selected()
+	p.Close()
+
+	if len(items) == 0 || fn == nil {
+		return
+	}
+
+	if p.origin.Exists() {
+		p.origin.Focus()
+	}
+	fn(items)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Rendering
+
+func (p *Picker) rerender() {
+	p.renderMutex.Lock()
+	defer p.renderMutex.Unlock()
+
+	p.mutex.Lock()
+	closed := p.closed
+	p.mutex.Unlock()
+
+	if closed {
+		return
+	}
+
+	p.renderer.ShouldRender()
+	p.highlight()
+	p.updateCursor()
+}
+
+func (p *Picker) highlight() {
+	p.mutex.Lock()
+	highlights := []neovim.Highlight{}
+	for i, m := range p.matches {
+		if i >= pickerHighlightLimit {
+			break
+		}
+
+		prefix := p.prefix(m.idx)
+		if p.marked[m.idx] {
+			highlights = append(highlights, neovim.Highlight{Group: "Type", Line: i + 1, StartCol: 0, EndCol: len(prefix)})
+		}
+
+		text := p.items[m.idx].String()
+		for _, pos := range m.positions {
+			r, size := utf8.DecodeRuneInString(text[pos:])
+			if r == utf8.RuneError {
+				size = 1
+			}
+			start := len(prefix) + pos
+			highlights = append(highlights, neovim.Highlight{Group: "Special", Line: i + 1, StartCol: start, EndCol: start + size})
+		}
+	}
+	p.mutex.Unlock()
+
+	p.results.ClearHighlights(p.namespace)
+	p.results.AddHighlights(p.namespace, highlights)
+}
+
+func (p *Picker) updateCursor() {
+	p.mutex.Lock()
+	current := p.current
+	closed := p.closed
+	p.mutex.Unlock()
+
+	if !closed && p.resultsWin != nil {
+		p.resultsWin.SetCursor(neovim.Cursor{current + 1, 0})
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Actions
+
+const (
+	PickerOpenEdit   = "edit"
+	PickerOpenSplit  = "split"
+	PickerOpenVSplit = "vsplit"
+	PickerOpenTab    = "tabedit"
+)
+
+// OpenFiles returns an action handler that opens the PickerFile items with the
+// ex command `cmd`, e.g. PickerOpenSplit. Other items are ignored.
+func OpenFiles(api *neovim.Api, cmd string) func([]PickerItem) {
+	return func(items []PickerItem) {
+		for _, item := range items {
+			if f, ok := item.(PickerFile); ok {
+				api.Executef("%s %s", cmd, fnameescape(f.Path()))
+			}
+		}
+	}
+}
+
+// fnameescape escapes `path` for ex commands, like fnameescape() does.
+func fnameescape(path string) string {
+	escaped := []rune{}
+	for i, r := range path {
+		if strings.ContainsRune(" \t\n*?[{`$\\%#'\"|!<", r) || (i == 0 && (r == '+' || r == '>' || r == '-')) {
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, r)
+	}
+	return string(escaped)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SliceSource is a PickerSource with a fixed list of items.
+type SliceSource []PickerItem
+
+func (s SliceSource) Items(items chan<- PickerItem, done <-chan struct{}) {
+	for _, item := range s {
+		select {
+		case items <- item:
+		case <-done:
+			return
+		}
+	}
+}
+
+// StringItem is a PickerItem for plain strings.
+type StringItem string
+
+func (s StringItem) String() string {
+	return string(s)
+}
+
+var _ PickerItem = StringItem("")
+var _ PickerSource = SliceSource(nil)
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,116 @@
+package neovim
+
+// WindowGeometry describes the position and the visible part of a window.
+type WindowGeometry struct {
+	// Row and Col of the top left corner on the screen (0-based).
+	Row    int
+	Col    int
+	Width  int
+	Height int
+
+	// first and last visible buffer line
+	TopLine int
+	BotLine int
+
+	// first visible column, if 'wrap' is off
+	LeftCol int
+
+	// width of the number, fold and sign columns
+	TextOff int
+}
+
+type windowInfo struct {
+	WinRow  int `msgpack:"winrow"`
+	WinCol  int `msgpack:"wincol"`
+	Width   int `msgpack:"width"`
+	Height  int `msgpack:"height"`
+	TopLine int `msgpack:"topline"`
+	BotLine int `msgpack:"botline"`
+	TextOff int `msgpack:"textoff"`
+	LeftCol int `msgpack:"leftcol"`
+}
+
+func (w *Window) Geometry() WindowGeometry {
+	var info windowInfo
+	w.api.nvim().ExecLua(`
+		local win = ...
+		local info = vim.fn.getwininfo(win)[1] or {}
+		info.leftcol = vim.api.nvim_win_call(win, function()
+			return vim.fn.winsaveview().leftcol
+		end)
+		return info
+	`, &info, w.id)
+
+	return WindowGeometry{
+		Row:     info.WinRow - 1,
+		Col:     info.WinCol - 1,
+		Width:   info.Width,
+		Height:  info.Height,
+		TopLine: info.TopLine,
+		BotLine: info.BotLine,
+		LeftCol: info.LeftCol,
+		TextOff: info.TextOff,
+	}
+}
+
+// WindowView is the state of a window returned by winsaveview().
+type WindowView struct {
+	Lnum     int `msgpack:"lnum"`
+	Col      int `msgpack:"col"`
+	ColAdd   int `msgpack:"coladd"`
+	CurSwant int `msgpack:"curswant"`
+	TopLine  int `msgpack:"topline"`
+	TopFill  int `msgpack:"topfill"`
+	LeftCol  int `msgpack:"leftcol"`
+	SkipCol  int `msgpack:"skipcol"`
+}
+
+// SaveView returns the cursor position and scroll state of the window.
+func (w *Window) SaveView() WindowView {
+	var view WindowView
+	w.api.nvim().ExecLua(`
+		local win = ...
+		return vim.api.nvim_win_call(win, vim.fn.winsaveview)
+	`, &view, w.id)
+	return view
+}
+
+// RestoreView restores a view returned by SaveView().
+func (w *Window) RestoreView(view WindowView) {
+	w.api.nvim().ExecLua(`
+		local win, view = ...
+		vim.api.nvim_win_call(win, function()
+			vim.fn.winrestview(view)
+		end)
+	`, nil, w.id, view)
+}
+
+// ScrollTo scrolls the window so that `line` is the first visible line.
+func (w *Window) ScrollTo(line int) {
+	w.api.nvim().ExecLua(`
+		local win, line = ...
+		vim.api.nvim_win_call(win, function()
+			vim.fn.winrestview({ topline = line })
+		end)
+	`, nil, w.id, line)
+}
+
+// ScrollBy scrolls the window `lines` lines down, or up for negative values,
+// like CTRL-E and CTRL-Y.
+func (w *Window) ScrollBy(lines int) {
+	switch {
+	case lines > 0:
+		w.normal(lines, "\x05")
+	case lines < 0:
+		w.normal(-lines, "\x19")
+	}
+}
+
+func (w *Window) normal(count int, keys string) {
+	w.api.nvim().ExecLua(`
+		local win, count, keys = ...
+		vim.api.nvim_win_call(win, function()
+			vim.cmd('normal! ' .. count .. keys)
+		end)
+	`, nil, w.id, count, keys)
+}
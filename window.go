@@ -1,12 +1,21 @@
 package neovim
 
-import "github.com/neovim/go-client/nvim"
+import (
+	"fmt"
+	"sync"
+
+	"github.com/josa42/go-neovim/disposables"
+	"github.com/neovim/go-client/nvim"
+)
 
 type Window struct {
-	api     *Api
-	id      nvim.Window
-	Vars    Vars
-	Options WindowOptions
+	api         *Api
+	id          nvim.Window
+	Vars        Vars
+	Options     WindowOptions
+	mutex       *sync.Mutex
+	disposables *disposables.Collection
+	tracked     bool
 }
 
 func newWindowById(api *Api, id nvim.Window) *Window {
@@ -17,10 +26,12 @@ func newWindowById(api *Api, id nvim.Window) *Window {
 	}
 
 	win := &Window{
-		api:     api,
-		id:      nvim.Window(id),
-		Vars:    newWindowVars(api, id),
-		Options: WindowOptions{api: api, windowID: id},
+		api:         api,
+		id:          nvim.Window(id),
+		Vars:        newWindowVars(api, id),
+		Options:     WindowOptions{api: api, windowID: id},
+		mutex:       &sync.Mutex{},
+		disposables: disposables.NewCollection(),
 	}
 
 	api.registry.add(RegistryTypeWindow, win)
@@ -78,6 +89,78 @@ func (w *Window) Close(force bool) {
 	w.api.nvim().CloseWindow(nvim.Window(w.id), force)
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Events
+
+const windowAutocmdLua = `
+	local group, event, win, fn, id = ...
+
+	local matches = function(args)
+		if event == 'WinClosed' then
+			return tonumber(args.match) == win
+		elseif event == 'WinScrolled' then
+			-- fired once for all scrolled windows, <amatch> is only the first one
+			return vim.v.event[tostring(win)] ~= nil
+		elseif event == 'WinResized' then
+			return vim.tbl_contains(vim.v.event.windows or {}, win)
+		end
+		return vim.api.nvim_get_current_win() == win
+	end
+
+	vim.api.nvim_create_autocmd(event, {
+		group = vim.api.nvim_create_augroup(group, { clear = true }),
+		callback = function(args)
+			if matches(args) then
+				vim.fn[fn](id)
+			end
+		end,
+	})
+`
+
+// On calls `fn` when `event` is triggered for the window. Supported events are
+// EventWinEnter, EventWinLeave, EventWinClosed, EventWinScrolled,
+// EventWinResized, EventCursorMoved and EventCursorMovedI. The subscription is
+// removed when the window is closed.
+func (w *Window) On(event string, fn func()) disposables.Disposable {
+	w.track()
+
+	handler := w.api.Handler.Create(fn)
+	groupName := fmt.Sprintf("window_%s", handler.uuid)
+
+	w.api.nvim().ExecLua(windowAutocmdLua, nil, groupName, event, w.id, handler.functionName, handler.uuid)
+
+	d := disposables.New(func() {
+		handler.Dispose()
+		w.api.Executef("silent! autocmd! %s", groupName)
+	})
+	w.disposables.Add(d)
+
+	return d
+}
+
+// track disposes the window subscriptions once the window is closed.
+func (w *Window) track() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.tracked {
+		return
+	}
+	w.tracked = true
+
+	handler := w.api.Handler.Create(func() {
+		w.disposables.Dispose()
+	})
+	groupName := fmt.Sprintf("window_%s", handler.uuid)
+
+	w.api.nvim().ExecLua(windowAutocmdLua, nil, groupName, EventWinClosed, w.id, handler.functionName, handler.uuid)
+
+	w.disposables.Add(handler)
+	w.disposables.Add(disposables.New(func() {
+		w.api.Executef("silent! autocmd! %s", groupName)
+	}))
+}
+
 // Vars
 
 func (b *Window) VarString(name string) string {
@@ -21,6 +21,8 @@ const (
 	WindowOptionWidth          IntOption    = "winwidth"       // int
 	WindowOptionColorColumn    StringOption = "colorcolumn"    // string
 	WindowOptionSpell          BoolOption   = "spell"          // bool
+	WindowOptionStatusLine     StringOption = "statusline"     // string
+	WindowOptionWinBar         StringOption = "winbar"         // string
 )
 
 // WindowOptionWidth
@@ -183,34 +185,44 @@ func (o *WindowOptions) SetWrap(value bool) {
 	o.setBool(WindowOptionWrap, value)
 }
 
+func (o *WindowOptions) StatusLine() string {
+	return o.getString(WindowOptionStatusLine)
+}
+
+func (o *WindowOptions) SetStatusLine(value string) {
+	o.setString(WindowOptionStatusLine, value)
+}
+
+func (o *WindowOptions) WinBar() string {
+	return o.getString(WindowOptionWinBar)
+}
+
+func (o *WindowOptions) SetWinBar(value string) {
+	o.setString(WindowOptionWinBar, value)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func (o *WindowOptions) getString(name StringOption) string {
-	var value string
-	o.api.nvim().WindowOption(o.windowID, string(name), &value)
-	return value
+	return o.Values().String(name)
 }
 
 func (o *WindowOptions) setString(name StringOption, value string) {
-	o.api.nvim().SetWindowOption(o.windowID, string(name), value)
+	o.Values().SetString(name, value)
 }
 
 func (o *WindowOptions) getBool(name BoolOption) bool {
-	var value bool
-	o.api.nvim().WindowOption(o.windowID, string(name), &value)
-	return value
+	return o.Values().Bool(name)
 }
 
 func (o *WindowOptions) setBool(name BoolOption, value bool) {
-	o.api.nvim().SetWindowOption(o.windowID, string(name), value)
+	o.Values().SetBool(name, value)
 }
 
 func (o *WindowOptions) getInt(name IntOption) int {
-	var value int
-	o.api.nvim().WindowOption(o.windowID, string(name), &value)
-	return value
+	return o.Values().Int(name)
 }
 
 func (o *WindowOptions) setInt(name IntOption, value int) {
-	o.api.nvim().SetWindowOption(o.windowID, string(name), value)
+	o.Values().SetInt(name, value)
 }